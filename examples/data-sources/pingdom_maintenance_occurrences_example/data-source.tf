@@ -0,0 +1,10 @@
+data "pingdom_maintenance_windows" "backup" {
+  description = "Weekly backup"
+}
+
+# The occurrences of the backup window in the next 30 days.
+data "pingdom_maintenance_occurrences" "backup" {
+  maintenance_id = data.pingdom_maintenance_windows.backup.ids[0]
+  from           = plantimestamp()
+  to             = timeadd(plantimestamp(), "720h")
+}
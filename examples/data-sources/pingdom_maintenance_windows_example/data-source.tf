@@ -0,0 +1,9 @@
+data "pingdom_maintenance_windows" "ongoing" {
+  state = "ongoing"
+}
+
+# The uptime checks which are currently in maintenance, e.g. to skip alerts in
+# other tools.
+output "checks_in_maintenance" {
+  value = toset(flatten(data.pingdom_maintenance_windows.ongoing.windows[*].uptime_check_ids))
+}
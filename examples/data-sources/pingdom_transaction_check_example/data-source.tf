@@ -0,0 +1,3 @@
+data "pingdom_transaction_check" "this" {
+  name = "Checkout"
+}
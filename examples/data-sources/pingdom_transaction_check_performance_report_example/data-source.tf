@@ -0,0 +1,16 @@
+data "pingdom_transaction_check" "checkout" {
+  name = "Checkout"
+}
+
+data "pingdom_transaction_check_performance_report" "this" {
+  check_id   = data.pingdom_transaction_check.checkout.id
+  from       = "2025-01-01T00:00:00Z"
+  to         = "2025-02-01T00:00:00Z"
+  resolution = "day"
+}
+
+output "checkout_uptime_percentage" {
+  value = 100 * sum(data.pingdom_transaction_check_performance_report.this.intervals[*].uptime) / sum([
+    for interval in data.pingdom_transaction_check_performance_report.this.intervals : interval.uptime + interval.downtime
+  ])
+}
@@ -0,0 +1,13 @@
+data "pingdom_transaction_check_status_report" "this" {
+  from = "2025-01-01T00:00:00Z"
+  to   = "2025-02-01T00:00:00Z"
+  tags = ["shop"]
+}
+
+output "outages" {
+  value = {
+    for check in data.pingdom_transaction_check_status_report.this.checks : check.name => [
+      for state in check.states : "${state.from} - ${state.to}: ${state.message}" if state.status == "failing"
+    ]
+  }
+}
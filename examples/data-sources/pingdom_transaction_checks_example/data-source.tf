@@ -0,0 +1,4 @@
+data "pingdom_transaction_checks" "this" {
+  tags = ["shop"]
+  type = "script"
+}
@@ -0,0 +1,7 @@
+resource "pingdom_dns_check" "this" {
+  name        = "Pingdom Terraform Example"
+  host        = "www.example.com"
+  nameserver  = "a.iana-servers.net"
+  expected_ip = "93.184.215.14"
+  regions     = ["EU"]
+}
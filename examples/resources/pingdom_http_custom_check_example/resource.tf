@@ -0,0 +1,7 @@
+resource "pingdom_http_custom_check" "this" {
+  name            = "Pingdom Terraform Example"
+  host            = "legacy.example.com"
+  url             = "/status.xml"
+  additional_urls = ["legacy.example.com/db.xml"]
+  regions         = ["EU"]
+}
@@ -0,0 +1,27 @@
+resource "pingdom_http_check" "shop" {
+  name      = "Shop"
+  host      = "shop.example.com"
+  frequency = "1m"
+  regions   = ["EU"]
+}
+
+# Suppress the alerts during the weekly backup on Sunday night until the end
+# of the year.
+resource "pingdom_maintenance" "this" {
+  description      = "Weekly backup"
+  from             = "2025-02-02T02:00:00+01:00"
+  to               = "2025-02-02T03:00:00+01:00"
+  recurrence_type  = "week"
+  repeat_every     = 1
+  effective_to     = "2025-12-31T00:00:00+01:00"
+  uptime_check_ids = [pingdom_http_check.shop.id]
+}
+
+# Cover all uptime checks of the payments service, including the ones added
+# later, without listing their IDs.
+resource "pingdom_maintenance" "payments" {
+  description = "Payment provider downtime"
+  from        = "2025-03-01T20:00:00Z"
+  to          = "2025-03-01T23:00:00Z"
+  check_tags  = ["service:payments"]
+}
@@ -0,0 +1,6 @@
+resource "pingdom_ping_check" "this" {
+  name      = "Pingdom Terraform Example"
+  host      = "google.com"
+  frequency = "1m"
+  regions   = ["EU"]
+}
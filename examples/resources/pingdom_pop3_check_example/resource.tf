@@ -0,0 +1,6 @@
+resource "pingdom_pop3_check" "this" {
+  name             = "Pingdom Terraform Example"
+  host             = "mail.example.com"
+  string_to_expect = "OK"
+  regions          = ["EU"]
+}
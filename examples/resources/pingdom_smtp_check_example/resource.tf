@@ -0,0 +1,18 @@
+variable "smtp_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "pingdom_smtp_check" "this" {
+  name             = "Pingdom Terraform Example"
+  host             = "mail.example.com"
+  port             = 465
+  encryption       = true
+  string_to_expect = "ESMTP"
+  auth = {
+    username            = "monitoring"
+    password_wo         = var.smtp_password
+    password_wo_version = 1
+  }
+}
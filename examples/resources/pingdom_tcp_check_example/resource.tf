@@ -0,0 +1,8 @@
+resource "pingdom_tcp_check" "this" {
+  name             = "Pingdom Terraform Example"
+  host             = "smtp.google.com"
+  port             = 25
+  string_to_expect = "220"
+  frequency        = "5m"
+  regions          = ["EU"]
+}
@@ -0,0 +1,20 @@
+resource "pingdom_transaction_check" "this" {
+  name     = "Pingdom Terraform Example"
+  interval = 10
+  region   = "eu"
+  tags     = ["login"]
+
+  steps = [
+    { fn = "go_to", args = { url = "https://www.example.com/login" } },
+    { fn = "fill", args = { input = "#email", value = "monitoring@example.com" } },
+    { fn = "fill", args = { input = "#password", value = "changeme" } },
+    { fn = "submit", args = { form = "#login" } },
+    { fn = "wait_for_element", args = { element = "#account" } },
+    { fn = "contains_text", args = { element = "h1", value = "Welcome" } },
+  ]
+
+  metadata = {
+    width  = 1280
+    height = 720
+  }
+}
@@ -0,0 +1,8 @@
+resource "pingdom_udp_check" "this" {
+  name             = "Pingdom Terraform Example"
+  host             = "game.example.com"
+  port             = 27015
+  string_to_send   = "ping"
+  string_to_expect = "pong"
+  regions          = ["EU"]
+}
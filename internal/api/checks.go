@@ -4,11 +4,62 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
 	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 )
 
+// GetChecksRequest filters the checks returned by GetChecks.
+type GetChecksRequest struct {
+	// Tags limits the result to checks with at least one of the tags.
+	Tags []string
+}
+
+// checksPageSize is the number of checks requested per page, which is the
+// maximum allowed by Pingdom.
+var checksPageSize = 25000
+
+// GetChecks returns all uptime checks matching the filter including their
+// tags, requesting as many pages as needed.
+func (client *client) GetChecks(ctx context.Context, filter GetChecksRequest) ([]api_types.CheckSummary, error) {
+	uri, err := url.JoinPath(client.baseURL, "checks")
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(checksPageSize))
+	query.Set("include_tags", "true")
+	if len(filter.Tags) > 0 {
+		query.Set("tags", strings.Join(filter.Tags, ","))
+	}
+
+	checks := []api_types.CheckSummary{}
+	for offset := 0; ; offset += checksPageSize {
+		query.Set("offset", strconv.Itoa(offset))
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri+"?"+query.Encode(), http.NoBody)
+		if err != nil {
+			return nil, err
+		}
+
+		var res *api_types.Checks
+		err = client.do(req, &res)
+		if err != nil {
+			return nil, err
+		}
+
+		checks = append(checks, res.Checks...)
+		if len(res.Checks) < checksPageSize {
+			return checks, nil
+		}
+	}
+}
+
 func (client *client) GetCheck(ctx context.Context, id string) (*api_types.Check, error) {
 	uri, err := url.JoinPath(client.baseURL, "checks", id)
 	if err != nil {
@@ -35,23 +86,172 @@ type CreateCheckRequest struct {
 	Name string `json:"name"`
 	Host string `json:"host"`
 	// Type needs to be empty for update requests
-	Type                     string   `json:"type,omitempty"`
-	Encryption               bool     `json:"encryption"`
-	CustomMessage            string   `json:"custom_message,omitempty"`
-	ProbeFilters             []string `json:"probe_filters,omitempty"`
+	Type          string `json:"type,omitempty"`
+	CustomMessage string `json:"custom_message,omitempty"`
+	// ProbeFilters is always sent, as an empty list removes all filters.
+	ProbeFilters             []string `json:"probe_filters"`
 	Resolution               float64  `json:"resolution"`
-	Auth                     string   `json:"auth,omitempty"`
 	NotifyAgainEvery         int64    `json:"notifyagainevery"`
 	NotifyWhenBackup         bool     `json:"notifywhenbackup"`
 	Paused                   bool     `json:"paused"`
-	Port                     int64    `json:"port,omitempty"`
+	IPv6                     bool     `json:"ipv6"`
 	ResponseTimeThreshold    int64    `json:"responsetime_threshold"`
 	SendNotificationWhenDown int64    `json:"sendnotificationwhendown"`
-	SSLDownDaysBefore        int64    `json:"ssl_down_days_before"`
-	Url                      string   `json:"url"`
 	Tags                     []string `json:"tags"`
 	UserIds                  string   `json:"userids"`
-	VerifyCertificate        bool     `json:"verify_certificate"`
+
+	// The parameters specific to the check type. Only the one matching Type is
+	// set, its parameters are sent next to the ones above, see MarshalJSON.
+	HTTP *HTTPCheckRequest `json:"-"`
+	TCP  *TCPCheckRequest  `json:"-"`
+	DNS  *DNSCheckRequest  `json:"-"`
+	UDP  *UDPCheckRequest  `json:"-"`
+	SMTP *SMTPCheckRequest `json:"-"`
+	POP3 *POP3CheckRequest `json:"-"`
+	IMAP *IMAPCheckRequest `json:"-"`
+
+	HTTPCustom *HTTPCustomCheckRequest `json:"-"`
+}
+
+type HTTPCheckRequest struct {
+	Url        string `json:"url"`
+	Encryption bool   `json:"encryption"`
+	Port       int64  `json:"port,omitempty"`
+	// Auth is "username:password", an empty value removes the credentials and
+	// nil keeps the current ones.
+	Auth              *string `json:"auth,omitempty"`
+	SSLDownDaysBefore int64   `json:"ssl_down_days_before"`
+	VerifyCertificate bool    `json:"verify_certificate"`
+	// ShouldContain and ShouldNotContain are always sent, as an empty value
+	// removes a previously configured string.
+	ShouldContain    string `json:"shouldcontain"`
+	ShouldNotContain string `json:"shouldnotcontain"`
+	// PostData turns the check into a POST request with the given body. It is
+	// always sent, as an empty value switches the check back to GET.
+	PostData string `json:"postdata"`
+	// RequestHeaders are sent as requestheader{N} parameters, see MarshalJSON.
+	RequestHeaders map[string]string `json:"-"`
+}
+
+type TCPCheckRequest struct {
+	Port int64 `json:"port"`
+	// StringToSend and StringToExpect are always sent, as an empty value
+	// removes a previously configured string.
+	StringToSend   string `json:"stringtosend"`
+	StringToExpect string `json:"stringtoexpect"`
+}
+
+type DNSCheckRequest struct {
+	NameServer string `json:"nameserver"`
+	ExpectedIP string `json:"expectedip"`
+}
+
+type UDPCheckRequest struct {
+	Port           int64  `json:"port"`
+	StringToSend   string `json:"stringtosend"`
+	StringToExpect string `json:"stringtoexpect"`
+}
+
+type SMTPCheckRequest struct {
+	Port int64 `json:"port"`
+	// Auth is "username:password", an empty value removes the credentials and
+	// nil keeps the current ones.
+	Auth           *string `json:"auth,omitempty"`
+	StringToExpect string  `json:"stringtoexpect"`
+	Encryption     bool    `json:"encryption"`
+}
+
+type POP3CheckRequest struct {
+	Port           int64  `json:"port"`
+	StringToExpect string `json:"stringtoexpect"`
+}
+
+type IMAPCheckRequest struct {
+	Port           int64  `json:"port"`
+	StringToExpect string `json:"stringtoexpect"`
+}
+
+type HTTPCustomCheckRequest struct {
+	Url        string `json:"url"`
+	Encryption bool   `json:"encryption"`
+	Port       int64  `json:"port,omitempty"`
+	// AdditionalURLs is a semicolon separated list of URLs including the host.
+	// It is always sent, as an empty value removes all additional URLs.
+	AdditionalURLs string `json:"additionalurls"`
+}
+
+// MarshalJSON encodes the request as a flat object of parameters. The
+// parameters of the check type are merged into the common ones and a
+// "requestheader{N}" parameter in the form "Name:Value" is added for each of
+// the RequestHeaders of an HTTP check as expected by Pingdom.
+func (body CreateCheckRequest) MarshalJSON() ([]byte, error) {
+	type plain CreateCheckRequest
+	fields, err := encodeFields(plain(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var options any
+	switch {
+	case body.HTTP != nil:
+		options = body.HTTP
+	case body.TCP != nil:
+		options = body.TCP
+	case body.DNS != nil:
+		options = body.DNS
+	case body.UDP != nil:
+		options = body.UDP
+	case body.SMTP != nil:
+		options = body.SMTP
+	case body.POP3 != nil:
+		options = body.POP3
+	case body.IMAP != nil:
+		options = body.IMAP
+	case body.HTTPCustom != nil:
+		options = body.HTTPCustom
+	}
+	if options != nil {
+		optionFields, err := encodeFields(options)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range optionFields {
+			fields[name] = value
+		}
+	}
+
+	if body.HTTP != nil {
+		names := make([]string, 0, len(body.HTTP.RequestHeaders))
+		for name := range body.HTTP.RequestHeaders {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for i, name := range names {
+			header, err := json.Marshal(name + ":" + body.HTTP.RequestHeaders[name])
+			if err != nil {
+				return nil, err
+			}
+			fields[fmt.Sprintf("requestheader%d", i)] = header
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+// encodeFields encodes the value into a map of its JSON fields.
+func encodeFields(value any) (map[string]json.RawMessage, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
 }
 
 func (client *client) CreateCheck(ctx context.Context, body CreateCheckRequest) (*int64, error) {
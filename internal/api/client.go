@@ -3,59 +3,165 @@ package api
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
 	"io"
 	"net/http"
+	"time"
 )
 
 type Client interface {
+	GetChecks(ctx context.Context, filter GetChecksRequest) ([]api_types.CheckSummary, error)
 	GetCheck(ctx context.Context, id string) (*api_types.Check, error)
 	CreateCheck(ctx context.Context, body CreateCheckRequest) (*int64, error)
 	UpdateCheck(ctx context.Context, id string, body CreateCheckRequest) error
 	DeleteCheck(ctx context.Context, id string) error
 
+	GetTransactionChecks(ctx context.Context, filter GetTransactionChecksRequest) ([]api_types.TransactionCheck, error)
+	GetTransactionCheck(ctx context.Context, id string) (*api_types.TransactionCheck, error)
+	CreateTransactionCheck(ctx context.Context, body CreateTransactionCheckRequest) (*api_types.TransactionCheck, error)
+	UpdateTransactionCheck(ctx context.Context, id string, body CreateTransactionCheckRequest) (*api_types.TransactionCheck, error)
+	DeleteTransactionCheck(ctx context.Context, id string) error
+
+	GetTransactionCheckPerformanceReport(ctx context.Context, id string, filter GetTransactionCheckPerformanceReportRequest) (*api_types.TransactionCheckPerformanceReport, error)
+	GetTransactionCheckStatusReports(ctx context.Context, filter GetTransactionCheckStatusReportsRequest) ([]api_types.TransactionCheckStatusReport, error)
+
+	GetMaintenanceWindows(ctx context.Context) ([]api_types.Maintenance, error)
+	GetMaintenance(ctx context.Context, id string) (*api_types.Maintenance, error)
+	CreateMaintenance(ctx context.Context, body CreateMaintenanceRequest) (*int64, error)
+	UpdateMaintenance(ctx context.Context, id string, body CreateMaintenanceRequest) error
+	DeleteMaintenance(ctx context.Context, id string) error
+	GetMaintenanceOccurrences(ctx context.Context, filter GetMaintenanceOccurrencesRequest) ([]api_types.MaintenanceOccurrence, error)
+
 	GetContacts(ctx context.Context) (*api_types.Contacts, error)
 }
 
+// DefaultBaseURL is the URL of the Pingdom API used when no other value has
+// been configured.
+const DefaultBaseURL = "https://api.pingdom.com/api/3.1"
+
 type client struct {
-	baseURL string
-	token   string
+	baseURL    string
+	token      string
+	httpClient *http.Client
+
+	maxRetries   int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+
+	limiter *rateLimiter
 }
 
-func New(token string) Client {
-	return &client{
-		token:   token,
-		baseURL: "https://api.pingdom.com/api/3.1",
+// Option configures optional settings of the client.
+type Option func(*client)
+
+// WithMaxRetries sets how often a failed request is retried before giving up.
+// A value of 0 disables retries.
+func WithMaxRetries(maxRetries int) Option {
+	return func(client *client) {
+		if maxRetries >= 0 {
+			client.maxRetries = maxRetries
+		}
 	}
 }
 
-func (client *client) do(req *http.Request, r any) error {
-	req.Header.Set("Authorization", "Bearer "+client.token)
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return err
+// WithBaseURL sets the URL of the Pingdom API, e.g. to use a proxy or a
+// stand-in server.
+func WithBaseURL(baseURL string) Option {
+	return func(client *client) {
+		client.baseURL = baseURL
 	}
+}
 
-	defer res.Body.Close()
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return err
+// WithHTTPClient sets the HTTP client used to send requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(client *client) {
+		if httpClient != nil {
+			client.httpClient = httpClient
+		}
 	}
+}
 
-	tflog.Debug(req.Context(), "Received Response", map[string]interface{}{
-		"body":   string(body),
-		"status": res.StatusCode,
-	})
-
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
+func New(token string, opts ...Option) Client {
+	c := &client{
+		token:        token,
+		baseURL:      DefaultBaseURL,
+		httpClient:   http.DefaultClient,
+		maxRetries:   DefaultMaxRetries,
+		retryWaitMin: defaultRetryWaitMin,
+		retryWaitMax: defaultRetryWaitMax,
+		limiter:      newRateLimiter(),
 	}
 
-	if err := json.Unmarshal(body, r); err != nil {
-		return err
+	for _, opt := range opts {
+		opt(c)
 	}
 
-	return nil
+	return c
+}
+
+func (client *client) do(req *http.Request, r any) error {
+	ctx := client.logContext(req.Context())
+	req.Header.Set("Authorization", "Bearer "+client.token)
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return err
+			}
+			req.Body = body
+		}
+
+		release, err := client.limiter.acquire(ctx)
+		if err != nil {
+			return err
+		}
+
+		logRequest(ctx, req)
+		res, err := client.httpClient.Do(req)
+
+		var body []byte
+		if err == nil {
+			client.limiter.update(res)
+			body, err = io.ReadAll(res.Body)
+			res.Body.Close()
+		}
+		release()
+
+		if err == nil {
+			logResponse(ctx, req, res, body)
+
+			if res.StatusCode == http.StatusOK {
+				return json.Unmarshal(body, r)
+			}
+		}
+
+		if attempt >= client.maxRetries || !shouldRetry(req, res, err) {
+			if err != nil {
+				return err
+			}
+
+			return newError(req, res, body)
+		}
+
+		wait := client.backoff(attempt+1, res)
+		fields := map[string]interface{}{
+			"method":      req.Method,
+			"url":         req.URL.String(),
+			"attempt":     attempt + 1,
+			"max_retries": client.maxRetries,
+			"wait":        wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = res.StatusCode
+		}
+		tflog.SubsystemWarn(ctx, logSubsystem, "Retrying Pingdom API request", fields)
+
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
 }
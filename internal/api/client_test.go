@@ -0,0 +1,300 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/scayle/terraform-provider-pingdom/internal/api/fake"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+func newTestClient(t *testing.T, baseURL string) *client {
+	t.Helper()
+
+	c, ok := New("test-token", WithBaseURL(baseURL)).(*client)
+	if !ok {
+		t.Fatal("expected New to return a *client")
+	}
+	c.retryWaitMin = time.Millisecond
+	c.retryWaitMax = 5 * time.Millisecond
+
+	return c
+}
+
+func TestClient_checkLifecycle(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	c := newTestClient(t, server.APIURL())
+	ctx := context.Background()
+
+	id, err := c.CreateCheck(ctx, CreateCheckRequest{
+		Name:       "Example",
+		Host:       "Example.com",
+		Type:       "http",
+		Resolution: 5,
+		Tags:       []string{"team:platform"},
+		UserIds:    "2,1",
+		HTTP: &HTTPCheckRequest{
+			Encryption: true,
+			Url:        "/",
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateCheck: %s", err)
+	}
+
+	checkID := strconv.FormatInt(*id, 10)
+	check, err := c.GetCheck(ctx, checkID)
+	if err != nil {
+		t.Fatalf("GetCheck: %s", err)
+	}
+	if check.Hostname != "example.com" {
+		t.Errorf("expected normalised hostname, got %q", check.Hostname)
+	}
+	if check.Type.HTTP.Port != 443 {
+		t.Errorf("expected port 443 for encrypted check, got %d", check.Type.HTTP.Port)
+	}
+	if len(check.UserIDs) != 2 || check.UserIDs[0] != 1 {
+		t.Errorf("expected sorted user IDs, got %v", check.UserIDs)
+	}
+
+	if err := c.DeleteCheck(ctx, checkID); err != nil {
+		t.Fatalf("DeleteCheck: %s", err)
+	}
+
+	_, err = c.GetCheck(ctx, checkID)
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestClient_getTransactionChecksPagination(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	pageSize := transactionChecksPageSize
+	transactionChecksPageSize = 2
+	defer func() { transactionChecksPageSize = pageSize }()
+
+	for i := 0; i < 5; i++ {
+		server.AddTransactionCheck(api_types.TransactionCheck{Name: strconv.Itoa(i), Type: "script", Tags: []string{"shop"}})
+	}
+	server.AddTransactionCheck(api_types.TransactionCheck{Name: "recorded", Type: "recorded", Tags: []string{"shop"}})
+	server.AddTransactionCheck(api_types.TransactionCheck{Name: "untagged", Type: "script"})
+
+	checks, err := newTestClient(t, server.APIURL()).GetTransactionChecks(context.Background(), GetTransactionChecksRequest{
+		Tags: []string{"shop", "blog"},
+		Type: "script",
+	})
+	if err != nil {
+		t.Fatalf("GetTransactionChecks: %s", err)
+	}
+
+	if len(checks) != 5 {
+		t.Fatalf("expected 5 checks, got %d", len(checks))
+	}
+	for i, check := range checks {
+		if check.Name != strconv.Itoa(i) {
+			t.Errorf("expected check %d to be named %d, got %q", i, i, check.Name)
+		}
+	}
+}
+
+func TestClient_getChecksPagination(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	pageSize := checksPageSize
+	checksPageSize = 2
+	defer func() { checksPageSize = pageSize }()
+
+	c := newTestClient(t, server.APIURL())
+	for i := 0; i < 6; i++ {
+		tags := []string{"service:payments"}
+		if i == 3 {
+			tags = []string{"service:search"}
+		}
+
+		_, err := c.CreateCheck(context.Background(), CreateCheckRequest{
+			Name:         strconv.Itoa(i),
+			Host:         "example.com",
+			Type:         "http",
+			Resolution:   5,
+			ProbeFilters: []string{},
+			Tags:         tags,
+		})
+		if err != nil {
+			t.Fatalf("CreateCheck: %s", err)
+		}
+	}
+
+	checks, err := c.GetChecks(context.Background(), GetChecksRequest{
+		Tags: []string{"service:payments", "service:checkout"},
+	})
+	if err != nil {
+		t.Fatalf("GetChecks: %s", err)
+	}
+
+	if len(checks) != 5 {
+		t.Fatalf("expected 5 checks, got %d", len(checks))
+	}
+	for _, check := range checks {
+		if check.Name == "3" {
+			t.Errorf("expected check 3 not to be listed")
+		}
+		if len(check.Tags) != 1 || check.Tags[0].Name != "service:payments" {
+			t.Errorf("unexpected tags %+v of check %s", check.Tags, check.Name)
+		}
+		if check.Type != "http" {
+			t.Errorf("expected type http, got %q", check.Type)
+		}
+	}
+}
+
+func TestClient_getMaintenanceWindowsPagination(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	pageSize := maintenancePageSize
+	maintenancePageSize = 2
+	defer func() { maintenancePageSize = pageSize }()
+
+	c := newTestClient(t, server.APIURL())
+	for i := 0; i < 4; i++ {
+		_, err := c.CreateMaintenance(context.Background(), CreateMaintenanceRequest{
+			Description:    strconv.Itoa(i),
+			From:           1735689600,
+			To:             1735693200,
+			RecurrenceType: "none",
+			EffectiveTo:    1735693200,
+		})
+		if err != nil {
+			t.Fatalf("CreateMaintenance: %s", err)
+		}
+	}
+
+	windows, err := c.GetMaintenanceWindows(context.Background())
+	if err != nil {
+		t.Fatalf("GetMaintenanceWindows: %s", err)
+	}
+
+	if len(windows) != 4 {
+		t.Fatalf("expected 4 maintenance windows, got %d", len(windows))
+	}
+	for i, window := range windows {
+		if window.Description != strconv.Itoa(i) {
+			t.Errorf("expected maintenance window %d to be described as %d, got %q", i, i, window.Description)
+		}
+	}
+}
+
+func TestClient_errorMessage(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	c := newTestClient(t, server.APIURL())
+
+	_, err := c.CreateCheck(context.Background(), CreateCheckRequest{
+		Name:         "Example",
+		Host:         "example.com",
+		Type:         "http",
+		Resolution:   5,
+		ProbeFilters: []string{"region: MARS"},
+	})
+
+	apiErr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected *Error, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Method != http.MethodPost || apiErr.Path != "/api/3.1/checks" {
+		t.Errorf("unexpected error details: %+v", apiErr)
+	}
+	if apiErr.Message == "" {
+		t.Errorf("expected the error message of the server to be decoded")
+	}
+}
+
+func TestClient_unauthorized(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	_, err := New("", WithBaseURL(server.APIURL())).GetContacts(context.Background())
+	if !IsUnauthorized(err) {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestClient_retry(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		status    int
+		wantCalls int32
+	}{
+		{name: "GET is retried on 502", method: http.MethodGet, status: http.StatusBadGateway, wantCalls: 3},
+		{name: "POST is retried on 429", method: http.MethodPost, status: http.StatusTooManyRequests, wantCalls: 3},
+		{name: "POST is not retried on 502", method: http.MethodPost, status: http.StatusBadGateway, wantCalls: 1},
+		{name: "POST is not retried on 503", method: http.MethodPost, status: http.StatusServiceUnavailable, wantCalls: 1},
+		{name: "GET is retried on 503", method: http.MethodGet, status: http.StatusServiceUnavailable, wantCalls: 3},
+		{name: "GET is not retried on 400", method: http.MethodGet, status: http.StatusBadRequest, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if calls.Add(1) < 3 {
+					w.WriteHeader(tt.status)
+					return
+				}
+				_, _ = w.Write([]byte(`{"check":{"id":1}}`))
+			}))
+			defer server.Close()
+
+			c := newTestClient(t, server.URL)
+			ctx := context.Background()
+
+			if tt.method == http.MethodGet {
+				_, _ = c.GetCheck(ctx, "1")
+			} else {
+				_, _ = c.CreateCheck(ctx, CreateCheckRequest{Name: "Example"})
+			}
+
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("expected %d calls, got %d", tt.wantCalls, got)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("Retry-After", "7")
+
+	wait, ok := retryAfter(res)
+	if !ok || wait != 7*time.Second {
+		t.Errorf("expected 7s, got %s (%t)", wait, ok)
+	}
+}
+
+func TestParseReqLimit(t *testing.T) {
+	now := time.Now()
+
+	window, ok := parseReqLimit("Remaining: 394 Time until reset: 3589", now)
+	if !ok {
+		t.Fatal("expected header to be parsed")
+	}
+	if window.remaining != 394 || !window.reset.Equal(now.Add(3589*time.Second)) {
+		t.Errorf("unexpected window: %+v", window)
+	}
+
+	if _, ok := parseReqLimit("garbage", now); ok {
+		t.Error("expected invalid header to be rejected")
+	}
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Error is returned by the client whenever the Pingdom API responds with a
+// status code other than 200.
+type Error struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Description is the short description of the status, e.g. "Bad Request".
+	Description string
+	// Message is the error message returned by Pingdom, e.g.
+	// "Invalid parameter value: probe_filters".
+	Message string
+
+	// Method and Path identify the request which failed.
+	Method string
+	Path   string
+}
+
+func (e *Error) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s: %d", e.Method, e.Path, e.StatusCode)
+	if e.Description != "" {
+		sb.WriteString(" " + e.Description)
+	}
+	if e.Message != "" {
+		sb.WriteString(": " + e.Message)
+	}
+
+	return sb.String()
+}
+
+// newError builds an Error from the response, decoding the error body Pingdom
+// returns if present.
+func newError(req *http.Request, res *http.Response, body []byte) *Error {
+	apiErr := &Error{
+		StatusCode:  res.StatusCode,
+		Description: http.StatusText(res.StatusCode),
+		Method:      req.Method,
+		Path:        req.URL.Path,
+	}
+
+	var payload struct {
+		Error struct {
+			StatusCode   int    `json:"statuscode"`
+			StatusDesc   string `json:"statusdesc"`
+			ErrorMessage string `json:"errormessage"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		if payload.Error.StatusDesc != "" {
+			apiErr.Description = payload.Error.StatusDesc
+		}
+		apiErr.Message = payload.Error.ErrorMessage
+	}
+
+	return apiErr
+}
+
+func hasStatus(err error, statusCode int) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// IsNotFound reports whether err is an Error for a resource which does not
+// exist. Besides a 404, Pingdom answers requests for deleted checks with other
+// client errors whose message reads e.g. "Check not found", which are treated
+// the same.
+func IsNotFound(err error) bool {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	if apiErr.StatusCode == http.StatusNotFound {
+		return true
+	}
+
+	return apiErr.StatusCode >= 400 && apiErr.StatusCode < 500 &&
+		strings.Contains(strings.ToLower(apiErr.Message), "not found")
+}
+
+// IsRateLimited reports whether err is an Error caused by exceeding the
+// request limit (429).
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsUnauthorized reports whether err is an Error caused by a missing or
+// invalid API token (401) or insufficient permissions (403).
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized) || hasStatus(err, http.StatusForbidden)
+}
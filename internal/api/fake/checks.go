@@ -0,0 +1,529 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+// probeFilterPattern matches the probe filters Pingdom accepts.
+var probeFilterPattern = regexp.MustCompile(`^region: (EU|NA|APAC|LATAM)$`)
+
+// requestHeaderPattern matches the requestheader{N} parameters used to set the
+// custom request headers of an HTTP check.
+var requestHeaderPattern = regexp.MustCompile(`^requestheader\d+$`)
+
+// checkField applies a single request parameter to a check.
+type checkField func(check *api_types.Check, raw json.RawMessage) error
+
+// checkFields lists the parameters accepted when creating or updating a check
+// of any type. Unknown parameters are rejected just like Pingdom does.
+var checkFields = map[string]checkField{
+	"name": func(check *api_types.Check, raw json.RawMessage) error {
+		return decodeNonEmpty(raw, &check.Name)
+	},
+	"host": func(check *api_types.Check, raw json.RawMessage) error {
+		if err := decodeNonEmpty(raw, &check.Hostname); err != nil {
+			return err
+		}
+		check.Hostname = strings.ToLower(check.Hostname)
+		return nil
+	},
+	"type": func(check *api_types.Check, raw json.RawMessage) error {
+		var checkType string
+		if err := json.Unmarshal(raw, &checkType); err != nil {
+			return err
+		}
+		if checkType != check.Type.Name() {
+			return fmt.Errorf("the type of a check can not be changed")
+		}
+		return nil
+	},
+	"ipv6": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Ipv6)
+	},
+	"paused": func(check *api_types.Check, raw json.RawMessage) error {
+		var paused bool
+		if err := json.Unmarshal(raw, &paused); err != nil {
+			return err
+		}
+		if paused {
+			check.Status = "paused"
+		} else if check.Status == "paused" {
+			check.Status = "unconfirmed"
+		}
+		return nil
+	},
+	"resolution": func(check *api_types.Check, raw json.RawMessage) error {
+		var resolution float64
+		if err := json.Unmarshal(raw, &resolution); err != nil {
+			return err
+		}
+		switch resolution {
+		case 1, 5, 15, 30, 60:
+			check.Resolution = int64(resolution)
+			return nil
+		default:
+			return fmt.Errorf("must be one of 1, 5, 15, 30 or 60")
+		}
+	},
+	"custom_message": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.CustomMessage)
+	},
+	"notifyagainevery": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.NotifyAgainEvery)
+	},
+	"notifywhenbackup": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.NotifyWhenBackup)
+	},
+	"sendnotificationwhendown": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.SendNotificationWhenDown)
+	},
+	"responsetime_threshold": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.ResponseTimeThreshold)
+	},
+	"probe_filters": func(check *api_types.Check, raw json.RawMessage) error {
+		var filters []string
+		if err := json.Unmarshal(raw, &filters); err != nil {
+			return err
+		}
+		for _, filter := range filters {
+			if !probeFilterPattern.MatchString(filter) {
+				return fmt.Errorf("invalid probe filter %q", filter)
+			}
+		}
+		sort.Strings(filters)
+		check.ProbeFilters = filters
+		return nil
+	},
+	"tags": func(check *api_types.Check, raw json.RawMessage) error {
+		var names []string
+		if err := json.Unmarshal(raw, &names); err != nil {
+			return err
+		}
+		sort.Strings(names)
+		tags := []api_types.CheckTag{}
+		for _, name := range names {
+			tags = append(tags, api_types.CheckTag{Name: name, Type: "u", Count: "1"})
+		}
+		check.Tags = tags
+		return nil
+	},
+	"userids": func(check *api_types.Check, raw json.RawMessage) error {
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		ids := []int64{}
+		for _, part := range strings.Split(value, ",") {
+			if part == "" {
+				continue
+			}
+			id, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+			if err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		check.UserIDs = ids
+		return nil
+	},
+}
+
+// checkTypes lists the supported check types with the parameters specific to
+// them and a function returning the options of a new check.
+var checkTypes = map[string]struct {
+	fields     map[string]checkField
+	newOptions func() api_types.CheckTypes
+}{
+	"http": {
+		fields: httpCheckFields,
+		newOptions: func() api_types.CheckTypes {
+			return api_types.CheckTypes{HTTP: &api_types.CheckHTTPOptions{
+				URL:               "/",
+				VerifyCertificate: true,
+				RequestHeaders: map[string]string{
+					"User-Agent": api_types.DefaultUserAgent,
+				},
+			}}
+		},
+	},
+	"tcp": {
+		fields: tcpCheckFields,
+		newOptions: func() api_types.CheckTypes {
+			return api_types.CheckTypes{TCP: &api_types.CheckTCPOptions{}}
+		},
+	},
+	"ping": {
+		fields: map[string]checkField{},
+		newOptions: func() api_types.CheckTypes {
+			return api_types.CheckTypes{Ping: &api_types.CheckPingOptions{}}
+		},
+	},
+	"dns": {
+		fields: dnsCheckFields,
+		newOptions: func() api_types.CheckTypes {
+			return api_types.CheckTypes{DNS: &api_types.CheckDNSOptions{}}
+		},
+	},
+	"udp": {
+		fields: udpCheckFields,
+		newOptions: func() api_types.CheckTypes {
+			return api_types.CheckTypes{UDP: &api_types.CheckUDPOptions{}}
+		},
+	},
+	"smtp": {
+		fields: smtpCheckFields,
+		newOptions: func() api_types.CheckTypes {
+			return api_types.CheckTypes{SMTP: &api_types.CheckSMTPOptions{Port: 25}}
+		},
+	},
+	"pop3": {
+		fields: pop3CheckFields,
+		newOptions: func() api_types.CheckTypes {
+			return api_types.CheckTypes{POP3: &api_types.CheckPOP3Options{Port: 110}}
+		},
+	},
+	"imap": {
+		fields: imapCheckFields,
+		newOptions: func() api_types.CheckTypes {
+			return api_types.CheckTypes{IMAP: &api_types.CheckIMAPOptions{Port: 143}}
+		},
+	},
+	"httpcustom": {
+		fields: httpCustomCheckFields,
+		newOptions: func() api_types.CheckTypes {
+			return api_types.CheckTypes{HTTPCustom: &api_types.CheckHTTPCustomOptions{AdditionalURLs: []string{}}}
+		},
+	},
+}
+
+var httpCheckFields = map[string]checkField{
+	"url": func(check *api_types.Check, raw json.RawMessage) error {
+		if err := json.Unmarshal(raw, &check.Type.HTTP.URL); err != nil {
+			return err
+		}
+		if check.Type.HTTP.URL == "" {
+			check.Type.HTTP.URL = "/"
+		}
+		if !strings.HasPrefix(check.Type.HTTP.URL, "/") {
+			return fmt.Errorf("must start with /")
+		}
+		return nil
+	},
+	"encryption": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Type.HTTP.Encryption)
+	},
+	"port": func(check *api_types.Check, raw json.RawMessage) error {
+		return decodePort(raw, &check.Type.HTTP.Port)
+	},
+	"verify_certificate": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Type.HTTP.VerifyCertificate)
+	},
+	"ssl_down_days_before": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Type.HTTP.SSLDownDaysBefore)
+	},
+	"shouldcontain": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Type.HTTP.ShouldContain)
+	},
+	"shouldnotcontain": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Type.HTTP.ShouldNotContain)
+	},
+	"postdata": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Type.HTTP.PostData)
+	},
+	"auth": func(check *api_types.Check, raw json.RawMessage) error {
+		return decodeAuth(raw, &check.Type.HTTP.Username, &check.Type.HTTP.Password)
+	},
+}
+
+var tcpCheckFields = map[string]checkField{
+	"port": func(check *api_types.Check, raw json.RawMessage) error {
+		return decodePort(raw, &check.Type.TCP.Port)
+	},
+	"stringtosend": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Type.TCP.StringToSend)
+	},
+	"stringtoexpect": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Type.TCP.StringToExpect)
+	},
+}
+
+var udpCheckFields = map[string]checkField{
+	"port": func(check *api_types.Check, raw json.RawMessage) error {
+		return decodePort(raw, &check.Type.UDP.Port)
+	},
+	"stringtosend": func(check *api_types.Check, raw json.RawMessage) error {
+		return decodeNonEmpty(raw, &check.Type.UDP.StringToSend)
+	},
+	"stringtoexpect": func(check *api_types.Check, raw json.RawMessage) error {
+		return decodeNonEmpty(raw, &check.Type.UDP.StringToExpect)
+	},
+}
+
+var httpCustomCheckFields = map[string]checkField{
+	"url": func(check *api_types.Check, raw json.RawMessage) error {
+		if err := decodeNonEmpty(raw, &check.Type.HTTPCustom.URL); err != nil {
+			return err
+		}
+		if !strings.HasPrefix(check.Type.HTTPCustom.URL, "/") {
+			return fmt.Errorf("must start with /")
+		}
+		return nil
+	},
+	"encryption": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Type.HTTPCustom.Encryption)
+	},
+	"port": func(check *api_types.Check, raw json.RawMessage) error {
+		return decodePort(raw, &check.Type.HTTPCustom.Port)
+	},
+	"additionalurls": func(check *api_types.Check, raw json.RawMessage) error {
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		urls := []string{}
+		for _, url := range strings.Split(value, ";") {
+			if url != "" {
+				urls = append(urls, url)
+			}
+		}
+		check.Type.HTTPCustom.AdditionalURLs = urls
+		return nil
+	},
+}
+
+var smtpCheckFields = map[string]checkField{
+	"port": func(check *api_types.Check, raw json.RawMessage) error {
+		return decodePort(raw, &check.Type.SMTP.Port)
+	},
+	"stringtoexpect": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Type.SMTP.StringToExpect)
+	},
+	"encryption": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Type.SMTP.Encryption)
+	},
+	"auth": func(check *api_types.Check, raw json.RawMessage) error {
+		return decodeAuth(raw, &check.Type.SMTP.Username, &check.Type.SMTP.Password)
+	},
+}
+
+var pop3CheckFields = map[string]checkField{
+	"port": func(check *api_types.Check, raw json.RawMessage) error {
+		return decodePort(raw, &check.Type.POP3.Port)
+	},
+	"stringtoexpect": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Type.POP3.StringToExpect)
+	},
+}
+
+var imapCheckFields = map[string]checkField{
+	"port": func(check *api_types.Check, raw json.RawMessage) error {
+		return decodePort(raw, &check.Type.IMAP.Port)
+	},
+	"stringtoexpect": func(check *api_types.Check, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Type.IMAP.StringToExpect)
+	},
+}
+
+var dnsCheckFields = map[string]checkField{
+	"nameserver": func(check *api_types.Check, raw json.RawMessage) error {
+		return decodeNonEmpty(raw, &check.Type.DNS.NameServer)
+	},
+	"expectedip": func(check *api_types.Check, raw json.RawMessage) error {
+		if err := decodeNonEmpty(raw, &check.Type.DNS.ExpectedIP); err != nil {
+			return err
+		}
+		if _, err := netip.ParseAddr(check.Type.DNS.ExpectedIP); err != nil {
+			return fmt.Errorf("must be an IP address")
+		}
+		return nil
+	},
+}
+
+// applyCheckFields applies the parameters of a create or update request to
+// the check.
+func applyCheckFields(check *api_types.Check, fields map[string]json.RawMessage, create bool) error {
+	if create {
+		for _, name := range []string{"name", "host", "type"} {
+			if _, ok := fields[name]; !ok {
+				return fmt.Errorf("Missing parameter: %s", name)
+			}
+		}
+
+		var checkType string
+		_ = json.Unmarshal(fields["type"], &checkType)
+		options, ok := checkTypes[checkType]
+		if !ok {
+			return fmt.Errorf("Invalid parameter value: type (unsupported check type)")
+		}
+		check.Type = options.newOptions()
+	}
+	typeFields := checkTypes[check.Type.Name()].fields
+
+	// Apply the parameters in a stable order to get deterministic errors.
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var requestHeaders []string
+	for _, name := range names {
+		if check.Type.HTTP != nil && requestHeaderPattern.MatchString(name) {
+			requestHeaders = append(requestHeaders, name)
+			continue
+		}
+
+		apply, ok := checkFields[name]
+		if !ok {
+			apply, ok = typeFields[name]
+		}
+		if !ok {
+			return fmt.Errorf("Invalid parameter: %s", name)
+		}
+
+		if err := apply(check, fields[name]); err != nil {
+			return fmt.Errorf("Invalid parameter value: %s (%s)", name, err)
+		}
+	}
+
+	if check.Ipv6 {
+		for _, filter := range check.ProbeFilters {
+			if filter != "region: EU" && filter != "region: NA" {
+				return fmt.Errorf("Invalid parameter value: ipv6 (no IPv6 probes available for %s)", filter)
+			}
+		}
+	}
+
+	switch {
+	case check.Type.HTTP != nil:
+		return applyHTTPCheckDefaults(check, fields, requestHeaders, create)
+	case check.Type.HTTPCustom != nil:
+		if check.Type.HTTPCustom.URL == "" {
+			return fmt.Errorf("Missing parameter: url")
+		}
+		applyDefaultPort(&check.Type.HTTPCustom.Port, check.Type.HTTPCustom.Encryption, fields, create)
+	case check.Type.TCP != nil:
+		if check.Type.TCP.Port == 0 {
+			return fmt.Errorf("Missing parameter: port")
+		}
+	case check.Type.DNS != nil:
+		if check.Type.DNS.NameServer == "" {
+			return fmt.Errorf("Missing parameter: nameserver")
+		}
+		if check.Type.DNS.ExpectedIP == "" {
+			return fmt.Errorf("Missing parameter: expectedip")
+		}
+	case check.Type.UDP != nil:
+		for name, value := range map[string]bool{
+			"port":           check.Type.UDP.Port != 0,
+			"stringtosend":   check.Type.UDP.StringToSend != "",
+			"stringtoexpect": check.Type.UDP.StringToExpect != "",
+		} {
+			if !value {
+				return fmt.Errorf("Missing parameter: %s", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyHTTPCheckDefaults applies the request headers of an HTTP check and
+// validates and fills in the parameters which depend on each other.
+func applyHTTPCheckDefaults(check *api_types.Check, fields map[string]json.RawMessage, requestHeaders []string, create bool) error {
+	// Any requestheader{N} parameter replaces all custom headers. Pingdom keeps
+	// its default User-Agent unless it is overridden.
+	if len(requestHeaders) > 0 {
+		headers := map[string]string{
+			"User-Agent": api_types.DefaultUserAgent,
+		}
+		for _, name := range requestHeaders {
+			var header string
+			if err := json.Unmarshal(fields[name], &header); err != nil {
+				return fmt.Errorf("Invalid parameter value: %s", name)
+			}
+
+			key, value, ok := strings.Cut(header, ":")
+			if !ok || key == "" {
+				return fmt.Errorf("Invalid parameter value: %s", name)
+			}
+			headers[key] = value
+		}
+		check.Type.HTTP.RequestHeaders = headers
+	}
+
+	if check.Type.HTTP.ShouldContain != "" && check.Type.HTTP.ShouldNotContain != "" {
+		return fmt.Errorf("Invalid parameter value: shouldcontain and shouldnotcontain are mutually exclusive")
+	}
+
+	applyDefaultPort(&check.Type.HTTP.Port, check.Type.HTTP.Encryption, fields, create)
+
+	return nil
+}
+
+// applyDefaultPort derives the port of HTTP based checks from the encryption
+// unless it was set explicitly, just like Pingdom does.
+func applyDefaultPort(port *int64, encryption bool, fields map[string]json.RawMessage, create bool) {
+	_, hasPort := fields["port"]
+	_, hasEncryption := fields["encryption"]
+	isDefaultPort := *port == 0 || *port == 80 || *port == 443
+	if !hasPort && (create || hasEncryption) && isDefaultPort {
+		*port = 80
+		if encryption {
+			*port = 443
+		}
+	}
+}
+
+func decodePort(raw json.RawMessage, port *int64) error {
+	if err := json.Unmarshal(raw, port); err != nil {
+		return err
+	}
+	if *port < 1 || *port > 65535 {
+		return fmt.Errorf("must be between 1 and 65535")
+	}
+
+	return nil
+}
+
+// decodeAuth decodes credentials in the form "username:password". An empty
+// string removes the credentials.
+func decodeAuth(raw json.RawMessage, username, password *string) error {
+	var auth string
+	if err := json.Unmarshal(raw, &auth); err != nil {
+		return err
+	}
+	if auth == "" {
+		*username = ""
+		*password = ""
+		return nil
+	}
+
+	var ok bool
+	*username, *password, ok = strings.Cut(auth, ":")
+	if !ok {
+		return fmt.Errorf("must be in the form username:password")
+	}
+
+	return nil
+}
+
+func decodeNonEmpty(raw json.RawMessage, value *string) error {
+	if err := json.Unmarshal(raw, value); err != nil {
+		return err
+	}
+	if *value == "" {
+		return fmt.Errorf("must not be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,421 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+// maintenanceField applies a single request parameter to a maintenance window.
+type maintenanceField func(maintenance *api_types.Maintenance, raw json.RawMessage) error
+
+// maintenanceFields lists the parameters accepted when creating or updating a
+// maintenance window.
+var maintenanceFields = map[string]maintenanceField{
+	"description": func(maintenance *api_types.Maintenance, raw json.RawMessage) error {
+		return decodeNonEmpty(raw, &maintenance.Description)
+	},
+	"from": func(maintenance *api_types.Maintenance, raw json.RawMessage) error {
+		return decodeTimestamp(raw, &maintenance.From)
+	},
+	"to": func(maintenance *api_types.Maintenance, raw json.RawMessage) error {
+		return decodeTimestamp(raw, &maintenance.To)
+	},
+	"recurrencetype": func(maintenance *api_types.Maintenance, raw json.RawMessage) error {
+		if err := json.Unmarshal(raw, &maintenance.RecurrenceType); err != nil {
+			return err
+		}
+		switch maintenance.RecurrenceType {
+		case "none", "day", "week", "month":
+			return nil
+		default:
+			return fmt.Errorf("must be one of none, day, week or month")
+		}
+	},
+	"repeatevery": func(maintenance *api_types.Maintenance, raw json.RawMessage) error {
+		if err := json.Unmarshal(raw, &maintenance.RepeatEvery); err != nil {
+			return err
+		}
+		if maintenance.RepeatEvery < 0 {
+			return fmt.Errorf("must not be negative")
+		}
+		return nil
+	},
+	"effectiveto": func(maintenance *api_types.Maintenance, raw json.RawMessage) error {
+		return decodeTimestamp(raw, &maintenance.EffectiveTo)
+	},
+	"uptimeids": func(maintenance *api_types.Maintenance, raw json.RawMessage) error {
+		return decodeIDList(raw, &maintenance.Checks.Uptime)
+	},
+	"tmsids": func(maintenance *api_types.Maintenance, raw json.RawMessage) error {
+		return decodeIDList(raw, &maintenance.Checks.TMS)
+	},
+}
+
+// Maintenance returns a copy of the maintenance window with the given ID.
+func (s *Server) Maintenance(id int64) (api_types.Maintenance, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	maintenance, ok := s.maintenance[id]
+	if !ok {
+		return api_types.Maintenance{}, false
+	}
+
+	return cloneMaintenance(maintenance), true
+}
+
+// UpdateMaintenance modifies a maintenance window in place, as if it was
+// changed in the Pingdom UI. It reports whether the window exists.
+func (s *Server) UpdateMaintenance(id int64, update func(maintenance *api_types.Maintenance)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	maintenance, ok := s.maintenance[id]
+	if ok {
+		update(maintenance)
+		s.scheduleOccurrences(maintenance)
+	}
+
+	return ok
+}
+
+// DeleteMaintenance removes a maintenance window, as if it was deleted in the
+// Pingdom UI.
+func (s *Server) DeleteMaintenance(id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.maintenance, id)
+	delete(s.occurrences, id)
+}
+
+func (s *Server) getMaintenanceWindows(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := 1000
+	if raw := query.Get("limit"); raw != "" {
+		var err error
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			writeError(w, http.StatusBadRequest, "Invalid parameter value: limit")
+			return
+		}
+	}
+
+	offset := 0
+	if raw := query.Get("offset"); raw != "" {
+		var err error
+		offset, err = strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			writeError(w, http.StatusBadRequest, "Invalid parameter value: offset")
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	windows := []api_types.Maintenance{}
+	for _, maintenance := range s.maintenance {
+		windows = append(windows, cloneMaintenance(maintenance))
+	}
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Id < windows[j].Id
+	})
+
+	windows = windows[min(offset, len(windows)):]
+	windows = windows[:min(limit, len(windows))]
+
+	writeJSON(w, map[string]any{"maintenance": windows})
+}
+
+func (s *Server) getMaintenance(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	maintenance, ok := s.lookupMaintenance(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, map[string]any{"maintenance": maintenance})
+}
+
+func (s *Server) createMaintenance(w http.ResponseWriter, r *http.Request) {
+	fields, ok := decodeFields(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	maintenance := &api_types.Maintenance{
+		RecurrenceType: "none",
+		Checks: api_types.MaintenanceChecks{
+			Uptime: []int64{},
+			TMS:    []int64{},
+		},
+	}
+	if err := s.applyMaintenanceFields(maintenance, fields, true); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	maintenance.Id = s.newID()
+	s.maintenance[maintenance.Id] = maintenance
+	s.scheduleOccurrences(maintenance)
+
+	writeJSON(w, map[string]any{"maintenance": map[string]any{"id": maintenance.Id}})
+}
+
+func (s *Server) updateMaintenance(w http.ResponseWriter, r *http.Request) {
+	fields, ok := decodeFields(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	maintenance, ok := s.lookupMaintenance(w, r)
+	if !ok {
+		return
+	}
+
+	// Apply the changes to a copy, so that invalid requests leave the window
+	// untouched.
+	updated := cloneMaintenance(maintenance)
+	if err := s.applyMaintenanceFields(&updated, fields, false); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	*maintenance = updated
+	s.scheduleOccurrences(maintenance)
+
+	writeJSON(w, map[string]any{"message": "Maintenance window successfully modified!"})
+}
+
+func (s *Server) deleteMaintenance(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	maintenance, ok := s.lookupMaintenance(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.maintenance, maintenance.Id)
+	delete(s.occurrences, maintenance.Id)
+
+	writeJSON(w, map[string]any{"message": "Maintenance window successfully deleted!"})
+}
+
+func (s *Server) getMaintenanceOccurrences(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var filter [3]int64
+	for i, name := range []string{"maintenanceid", "from", "to"} {
+		if raw := query.Get(name); raw != "" {
+			value, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil || value < 0 {
+				writeError(w, http.StatusBadRequest, "Invalid parameter value: "+name)
+				return
+			}
+			filter[i] = value
+		}
+	}
+	maintenanceId, from, to := filter[0], filter[1], filter[2]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	occurrences := []api_types.MaintenanceOccurrence{}
+	for id, scheduled := range s.occurrences {
+		if maintenanceId != 0 && id != maintenanceId {
+			continue
+		}
+
+		for _, occurrence := range scheduled {
+			if from != 0 && occurrence.To < from {
+				continue
+			}
+			if to != 0 && occurrence.From > to {
+				continue
+			}
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+	sort.Slice(occurrences, func(i, j int) bool {
+		if occurrences[i].From != occurrences[j].From {
+			return occurrences[i].From < occurrences[j].From
+		}
+		return occurrences[i].Id < occurrences[j].Id
+	})
+
+	writeJSON(w, map[string]any{"occurrences": occurrences})
+}
+
+// scheduleOccurrences replaces the occurrences of the maintenance window
+// according to its recurrence. Every repetition which ends before the end of
+// the recurrence is an occurrence.
+func (s *Server) scheduleOccurrences(maintenance *api_types.Maintenance) {
+	occurrences := []api_types.MaintenanceOccurrence{}
+
+	from := time.Unix(maintenance.From, 0).UTC()
+	duration := time.Duration(maintenance.To-maintenance.From) * time.Second
+	for i := 0; ; i++ {
+		start := from
+		switch maintenance.RecurrenceType {
+		case "day":
+			start = from.AddDate(0, 0, i*int(maintenance.RepeatEvery))
+		case "week":
+			start = from.AddDate(0, 0, 7*i*int(maintenance.RepeatEvery))
+		case "month":
+			start = from.AddDate(0, i*int(maintenance.RepeatEvery), 0)
+		}
+
+		// Windows without recurrence, or repeated every 0 days, only occur once.
+		end := start.Add(duration)
+		if i > 0 && (!start.After(from) || end.Unix() > maintenance.EffectiveTo) {
+			break
+		}
+
+		occurrences = append(occurrences, api_types.MaintenanceOccurrence{
+			Id:            s.newID(),
+			MaintenanceId: maintenance.Id,
+			From:          start.Unix(),
+			To:            end.Unix(),
+		})
+	}
+
+	s.occurrences[maintenance.Id] = occurrences
+}
+
+// lookupMaintenance returns the maintenance window referenced by the path of
+// the request, or writes Pingdom's error response if there is no such window.
+func (s *Server) lookupMaintenance(w http.ResponseWriter, r *http.Request) (*api_types.Maintenance, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid maintenance ID")
+		return nil, false
+	}
+
+	maintenance, ok := s.maintenance[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Maintenance window not found")
+		return nil, false
+	}
+
+	return maintenance, true
+}
+
+// applyMaintenanceFields applies the parameters of a create or update request
+// to the maintenance window and validates the result.
+func (s *Server) applyMaintenanceFields(maintenance *api_types.Maintenance, fields map[string]json.RawMessage, create bool) error {
+	if create {
+		for _, name := range []string{"description", "from", "to"} {
+			if _, ok := fields[name]; !ok {
+				return fmt.Errorf("Missing parameter: %s", name)
+			}
+		}
+	}
+
+	// Apply the parameters in a stable order to get deterministic errors.
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		apply, ok := maintenanceFields[name]
+		if !ok {
+			return fmt.Errorf("Invalid parameter: %s", name)
+		}
+
+		if err := apply(maintenance, fields[name]); err != nil {
+			return fmt.Errorf("Invalid parameter value: %s (%s)", name, err)
+		}
+	}
+
+	// The recurrence ends with the first window by default.
+	if _, ok := fields["effectiveto"]; !ok && create {
+		maintenance.EffectiveTo = maintenance.To
+	}
+
+	if maintenance.From >= maintenance.To {
+		return fmt.Errorf("Invalid parameter value: from must be before to")
+	}
+	if maintenance.EffectiveTo < maintenance.To {
+		return fmt.Errorf("Invalid parameter value: effectiveto must not be before to")
+	}
+
+	for _, id := range maintenance.Checks.Uptime {
+		if _, ok := s.checks[id]; !ok {
+			return fmt.Errorf("Invalid parameter value: uptimeids (check %d not found)", id)
+		}
+	}
+	for _, id := range maintenance.Checks.TMS {
+		if _, ok := s.transactionChecks[id]; !ok {
+			return fmt.Errorf("Invalid parameter value: tmsids (check %d not found)", id)
+		}
+	}
+
+	return nil
+}
+
+// cloneMaintenance returns a copy of the maintenance window which shares no
+// slices with it.
+func cloneMaintenance(maintenance *api_types.Maintenance) api_types.Maintenance {
+	clone := *maintenance
+	clone.Checks.Uptime = append([]int64{}, maintenance.Checks.Uptime...)
+	clone.Checks.TMS = append([]int64{}, maintenance.Checks.TMS...)
+
+	return clone
+}
+
+func decodeTimestamp(raw json.RawMessage, timestamp *int64) error {
+	if err := json.Unmarshal(raw, timestamp); err != nil {
+		return err
+	}
+	if *timestamp <= 0 {
+		return fmt.Errorf("must be a positive UNIX timestamp")
+	}
+
+	return nil
+}
+
+// decodeIDList decodes a comma separated list of IDs. An empty string is an
+// empty list.
+func decodeIDList(raw json.RawMessage, ids *[]int64) error {
+	var encoded string
+	if err := json.Unmarshal(raw, &encoded); err != nil {
+		return err
+	}
+
+	decoded := []int64{}
+	if encoded != "" {
+		for _, value := range strings.Split(encoded, ",") {
+			id, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+			if err != nil {
+				return fmt.Errorf("%q is not an ID", value)
+			}
+			if !slices.Contains(decoded, id) {
+				decoded = append(decoded, id)
+			}
+		}
+	}
+	slices.Sort(decoded)
+	*ids = decoded
+
+	return nil
+}
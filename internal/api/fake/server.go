@@ -0,0 +1,435 @@
+// Package fake provides an in-memory implementation of the subset of the
+// Pingdom API 3.1 used by the provider, so that the provider can be tested
+// without a Pingdom account.
+//
+// The server mimics the behaviour of Pingdom as closely as needed for the
+// provider: it validates parameters, fills in defaults, normalises values the
+// same way Pingdom does and answers failures with Pingdom's error body.
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+// BasePath is the path under which the API is served, matching the path of
+// the real Pingdom API.
+const BasePath = "/api/3.1"
+
+// Server is an in-memory Pingdom API served over HTTP.
+type Server struct {
+	*httptest.Server
+
+	mu                sync.Mutex
+	nextID            int64
+	checks            map[int64]*api_types.Check
+	transactionChecks map[int64]*api_types.TransactionCheck
+	maintenance       map[int64]*api_types.Maintenance
+	occurrences       map[int64][]api_types.MaintenanceOccurrence
+	contacts          []api_types.Contact
+
+	transactionCheckPerformance map[int64]map[string][]api_types.TransactionCheckPerformanceInterval
+	transactionCheckStates      map[int64][]api_types.TransactionCheckState
+}
+
+// NewServer starts a new server. It must be closed by the caller.
+func NewServer() *Server {
+	s := &Server{
+		nextID:            1000,
+		checks:            map[int64]*api_types.Check{},
+		transactionChecks: map[int64]*api_types.TransactionCheck{},
+		maintenance:       map[int64]*api_types.Maintenance{},
+		occurrences:       map[int64][]api_types.MaintenanceOccurrence{},
+
+		transactionCheckPerformance: map[int64]map[string][]api_types.TransactionCheckPerformanceInterval{},
+		transactionCheckStates:      map[int64][]api_types.TransactionCheckState{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+BasePath+"/checks", s.getChecks)
+	mux.HandleFunc("GET "+BasePath+"/checks/{id}", s.getCheck)
+	mux.HandleFunc("POST "+BasePath+"/checks", s.createCheck)
+	mux.HandleFunc("PUT "+BasePath+"/checks/{id}", s.updateCheck)
+	mux.HandleFunc("DELETE "+BasePath+"/checks/{id}", s.deleteCheck)
+	mux.HandleFunc("GET "+BasePath+"/tms/check", s.getTransactionChecks)
+	mux.HandleFunc("GET "+BasePath+"/tms/check/{id}", s.getTransactionCheck)
+	mux.HandleFunc("POST "+BasePath+"/tms/check", s.createTransactionCheck)
+	mux.HandleFunc("PUT "+BasePath+"/tms/check/{id}", s.updateTransactionCheck)
+	mux.HandleFunc("DELETE "+BasePath+"/tms/check/{id}", s.deleteTransactionCheck)
+	mux.HandleFunc("GET "+BasePath+"/tms/check/{id}/report/performance", s.getTransactionCheckPerformanceReport)
+	mux.HandleFunc("GET "+BasePath+"/tms/check/report/status", s.getTransactionCheckStatusReports)
+	mux.HandleFunc("GET "+BasePath+"/maintenance", s.getMaintenanceWindows)
+	mux.HandleFunc("GET "+BasePath+"/maintenance/{id}", s.getMaintenance)
+	mux.HandleFunc("POST "+BasePath+"/maintenance", s.createMaintenance)
+	mux.HandleFunc("PUT "+BasePath+"/maintenance/{id}", s.updateMaintenance)
+	mux.HandleFunc("DELETE "+BasePath+"/maintenance/{id}", s.deleteMaintenance)
+	mux.HandleFunc("GET "+BasePath+"/maintenance.occurrences", s.getMaintenanceOccurrences)
+	mux.HandleFunc("GET "+BasePath+"/alerting/contacts", s.getContacts)
+
+	s.Server = httptest.NewServer(s.authenticate(mux))
+
+	return s
+}
+
+// APIURL returns the base URL of the API which can be passed to the client.
+func (s *Server) APIURL() string {
+	return s.URL + BasePath
+}
+
+// AddContact creates a contact and returns its ID.
+func (s *Server) AddContact(name string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := s.newID()
+	s.contacts = append(s.contacts, api_types.Contact{
+		Id:   id,
+		Name: name,
+		Type: "contact",
+	})
+
+	return id
+}
+
+// AddCheck stores the check as is, e.g. to add checks which are not managed
+// by Terraform, and returns its ID.
+func (s *Server) AddCheck(check api_types.Check) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clone := cloneCheck(&check)
+	clone.Id = s.newID()
+	s.checks[clone.Id] = &clone
+
+	return clone.Id
+}
+
+// Check returns a copy of the check with the given ID.
+func (s *Server) Check(id int64) (api_types.Check, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.checks[id]
+	if !ok {
+		return api_types.Check{}, false
+	}
+
+	return cloneCheck(check), true
+}
+
+// UpdateCheck modifies a check in place, as if it was changed in the Pingdom
+// UI. It reports whether the check exists.
+func (s *Server) UpdateCheck(id int64, update func(check *api_types.Check)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.checks[id]
+	if ok {
+		update(check)
+	}
+
+	return ok
+}
+
+// DeleteCheck removes a check, as if it was deleted in the Pingdom UI.
+func (s *Server) DeleteCheck(id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.checks, id)
+}
+
+func (s *Server) newID() int64 {
+	s.nextID++
+	return s.nextID
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := bearerToken(r)
+		if !ok || token == "" {
+			writeError(w, http.StatusUnauthorized, "Authentication required")
+			return
+		}
+
+		w.Header().Set("Req-Limit-Short", "Remaining: 394 Time until reset: 3589")
+		w.Header().Set("Req-Limit-Long", "Remaining: 71994 Time until reset: 2591989")
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) getChecks(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := 25000
+	if raw := query.Get("limit"); raw != "" {
+		var err error
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > 25000 {
+			writeError(w, http.StatusBadRequest, "Invalid parameter value: limit")
+			return
+		}
+	}
+
+	offset := 0
+	if raw := query.Get("offset"); raw != "" {
+		var err error
+		offset, err = strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			writeError(w, http.StatusBadRequest, "Invalid parameter value: offset")
+			return
+		}
+	}
+
+	includeTags := query.Get("include_tags") == "true"
+
+	var tags []string
+	if raw := query.Get("tags"); raw != "" {
+		tags = strings.Split(raw, ",")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checks := []api_types.CheckSummary{}
+	for _, check := range s.checks {
+		if len(tags) > 0 && !slices.ContainsFunc(check.Tags, func(tag api_types.CheckTag) bool { return slices.Contains(tags, tag.Name) }) {
+			continue
+		}
+
+		summary := api_types.CheckSummary{
+			Id:       check.Id,
+			Name:     check.Name,
+			Type:     check.Type.Name(),
+			Hostname: check.Hostname,
+			Status:   check.Status,
+		}
+		// Pingdom only lists the tags when asked to.
+		if includeTags {
+			summary.Tags = append([]api_types.CheckTag{}, check.Tags...)
+		}
+		checks = append(checks, summary)
+	}
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].Id < checks[j].Id
+	})
+
+	checks = checks[min(offset, len(checks)):]
+	checks = checks[:min(limit, len(checks))]
+
+	writeJSON(w, api_types.Checks{Checks: checks})
+}
+
+func (s *Server) getCheck(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.lookupCheck(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, map[string]any{"check": check})
+}
+
+func (s *Server) createCheck(w http.ResponseWriter, r *http.Request) {
+	fields, ok := decodeFields(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check := &api_types.Check{
+		Resolution:               5,
+		SendNotificationWhenDown: 2,
+		NotifyWhenBackup:         true,
+		ResponseTimeThreshold:    30000,
+		Status:                   "unconfirmed",
+		Tags:                     []api_types.CheckTag{},
+		ProbeFilters:             []string{},
+		UserIDs:                  []int64{},
+	}
+	if err := applyCheckFields(check, fields, true); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	check.Id = s.newID()
+	check.Created = time.Now().Unix()
+	s.checks[check.Id] = check
+
+	writeJSON(w, map[string]any{
+		"check": map[string]any{
+			"id":   check.Id,
+			"name": check.Name,
+		},
+	})
+}
+
+func (s *Server) updateCheck(w http.ResponseWriter, r *http.Request) {
+	fields, ok := decodeFields(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.lookupCheck(w, r)
+	if !ok {
+		return
+	}
+
+	if _, ok := fields["type"]; ok {
+		writeError(w, http.StatusBadRequest, "Invalid parameter: type")
+		return
+	}
+
+	// Apply the changes to a copy, so that invalid requests leave the check
+	// untouched.
+	updated := cloneCheck(check)
+	if err := applyCheckFields(&updated, fields, false); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	*check = updated
+
+	writeJSON(w, map[string]any{"message": "Modification of check was successful!"})
+}
+
+func (s *Server) deleteCheck(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.lookupCheck(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.checks, check.Id)
+
+	writeJSON(w, map[string]any{"message": "Deletion of check was successful!"})
+}
+
+func (s *Server) getContacts(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contacts := append([]api_types.Contact{}, s.contacts...)
+	sort.Slice(contacts, func(i, j int) bool {
+		return contacts[i].Name < contacts[j].Name
+	})
+
+	writeJSON(w, api_types.Contacts{Contacts: contacts})
+}
+
+// lookupCheck returns the check referenced by the path of the request, or
+// writes Pingdom's error response if there is no such check.
+func (s *Server) lookupCheck(w http.ResponseWriter, r *http.Request) (*api_types.Check, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid parameter value: checkid")
+		return nil, false
+	}
+
+	check, ok := s.checks[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Check not found")
+		return nil, false
+	}
+
+	return check, true
+}
+
+// cloneCheck returns a copy of the check which shares no options with it.
+func cloneCheck(check *api_types.Check) api_types.Check {
+	clone := *check
+	if check.Type.HTTP != nil {
+		options := *check.Type.HTTP
+		clone.Type.HTTP = &options
+	}
+	if check.Type.TCP != nil {
+		options := *check.Type.TCP
+		clone.Type.TCP = &options
+	}
+	if check.Type.DNS != nil {
+		options := *check.Type.DNS
+		clone.Type.DNS = &options
+	}
+	if check.Type.UDP != nil {
+		options := *check.Type.UDP
+		clone.Type.UDP = &options
+	}
+	if check.Type.SMTP != nil {
+		options := *check.Type.SMTP
+		clone.Type.SMTP = &options
+	}
+	if check.Type.POP3 != nil {
+		options := *check.Type.POP3
+		clone.Type.POP3 = &options
+	}
+	if check.Type.IMAP != nil {
+		options := *check.Type.IMAP
+		clone.Type.IMAP = &options
+	}
+	if check.Type.HTTPCustom != nil {
+		options := *check.Type.HTTPCustom
+		options.AdditionalURLs = append([]string{}, options.AdditionalURLs...)
+		clone.Type.HTTPCustom = &options
+	}
+	if check.Type.Ping != nil {
+		clone.Type.Ping = &api_types.CheckPingOptions{}
+	}
+
+	return clone
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	const prefix = "Bearer "
+
+	header := r.Header.Get("Authorization")
+	if len(header) < len(prefix) || header[:len(prefix)] != prefix {
+		return "", false
+	}
+
+	return header[len(prefix):], true
+}
+
+func decodeFields(w http.ResponseWriter, r *http.Request) (map[string]json.RawMessage, bool) {
+	var fields map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body")
+		return nil, false
+	}
+
+	return fields, true
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{
+			"statuscode":   statusCode,
+			"statusdesc":   http.StatusText(statusCode),
+			"errormessage": message,
+		},
+	})
+}
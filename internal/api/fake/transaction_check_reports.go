@@ -0,0 +1,224 @@
+package fake
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+// AddTransactionCheckPerformance adds intervals to the performance report of
+// the transaction check for the given resolution.
+func (s *Server) AddTransactionCheckPerformance(id int64, resolution string, intervals ...api_types.TransactionCheckPerformanceInterval) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.transactionCheckPerformance[id] == nil {
+		s.transactionCheckPerformance[id] = map[string][]api_types.TransactionCheckPerformanceInterval{}
+	}
+	s.transactionCheckPerformance[id][resolution] = append(s.transactionCheckPerformance[id][resolution], intervals...)
+}
+
+// AddTransactionCheckStates adds status periods to the status report of the
+// transaction check.
+func (s *Server) AddTransactionCheckStates(id int64, states ...api_types.TransactionCheckState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.transactionCheckStates[id] = append(s.transactionCheckStates[id], states...)
+}
+
+func (s *Server) getTransactionCheckPerformanceReport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	from, to, ok := decodeReportRange(w, query)
+	if !ok {
+		return
+	}
+
+	resolution := query.Get("resolution")
+	if resolution == "" {
+		resolution = "hour"
+	}
+	if resolution != "hour" && resolution != "day" && resolution != "week" {
+		writeError(w, http.StatusBadRequest, "Invalid parameter value: resolution")
+		return
+	}
+
+	includeUptime := query.Get("include_uptime") == "true"
+	descending, ok := decodeReportOrder(w, query)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.lookupTransactionCheck(w, r)
+	if !ok {
+		return
+	}
+
+	intervals := []api_types.TransactionCheckPerformanceInterval{}
+	for _, interval := range s.transactionCheckPerformance[check.Id][resolution] {
+		if interval.From.Before(from) || !interval.From.Before(to) {
+			continue
+		}
+		if !includeUptime {
+			interval.Uptime, interval.Downtime, interval.Unmonitored = 0, 0, 0
+		}
+		intervals = append(intervals, interval)
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		if descending {
+			return intervals[i].From.After(intervals[j].From)
+		}
+		return intervals[i].From.Before(intervals[j].From)
+	})
+
+	writeJSON(w, map[string]any{
+		"report": api_types.TransactionCheckPerformanceReport{
+			CheckId:    check.Id,
+			Name:       check.Name,
+			Resolution: resolution,
+			Intervals:  intervals,
+		},
+	})
+}
+
+func (s *Server) getTransactionCheckStatusReports(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	from, to, ok := decodeReportRange(w, query)
+	if !ok {
+		return
+	}
+
+	descending, ok := decodeReportOrder(w, query)
+	if !ok {
+		return
+	}
+
+	limit := 100
+	if raw := query.Get("limit"); raw != "" {
+		var err error
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > 1000 {
+			writeError(w, http.StatusBadRequest, "Invalid parameter value: limit")
+			return
+		}
+	}
+
+	offset := 0
+	if raw := query.Get("offset"); raw != "" {
+		var err error
+		offset, err = strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			writeError(w, http.StatusBadRequest, "Invalid parameter value: offset")
+			return
+		}
+	}
+
+	checkType := query.Get("type")
+	if checkType != "" && checkType != "script" && checkType != "recorded" {
+		writeError(w, http.StatusBadRequest, "Invalid parameter value: type")
+		return
+	}
+
+	var tags []string
+	if raw := query.Get("tags"); raw != "" {
+		tags = strings.Split(raw, ",")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reports := []api_types.TransactionCheckStatusReport{}
+	for _, check := range s.transactionChecks {
+		if checkType != "" && check.Type != checkType {
+			continue
+		}
+		if len(tags) > 0 && !slices.ContainsFunc(check.Tags, func(tag string) bool { return slices.Contains(tags, tag) }) {
+			continue
+		}
+
+		states := []api_types.TransactionCheckState{}
+		for _, state := range s.transactionCheckStates[check.Id] {
+			if state.To.After(from) && state.From.Before(to) {
+				states = append(states, state)
+			}
+		}
+		sort.Slice(states, func(i, j int) bool {
+			if descending {
+				return states[i].From.After(states[j].From)
+			}
+			return states[i].From.Before(states[j].From)
+		})
+		if query.Get("omit_empty") == "true" && len(states) == 0 {
+			continue
+		}
+
+		reports = append(reports, api_types.TransactionCheckStatusReport{
+			CheckId: check.Id,
+			Name:    check.Name,
+			States:  states,
+		})
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].CheckId < reports[j].CheckId
+	})
+
+	reports = reports[min(offset, len(reports)):]
+	reports = reports[:min(limit, len(reports))]
+
+	writeJSON(w, map[string]any{"report": reports})
+}
+
+// decodeReportRange returns the from and to parameters of a report request, or
+// writes Pingdom's error response if they are invalid.
+func decodeReportRange(w http.ResponseWriter, query url.Values) (time.Time, time.Time, bool) {
+	to := time.Now()
+	if raw := query.Get("to"); raw != "" {
+		var err error
+		to, err = time.Parse(time.RFC3339, raw)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid parameter value: to")
+			return time.Time{}, time.Time{}, false
+		}
+	}
+
+	from := to.Add(-24 * time.Hour)
+	if raw := query.Get("from"); raw != "" {
+		var err error
+		from, err = time.Parse(time.RFC3339, raw)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid parameter value: from")
+			return time.Time{}, time.Time{}, false
+		}
+	}
+
+	if !from.Before(to) {
+		writeError(w, http.StatusBadRequest, "Invalid parameter value: from must be before to")
+		return time.Time{}, time.Time{}, false
+	}
+
+	return from, to, true
+}
+
+// decodeReportOrder reports whether a report is requested in descending order.
+func decodeReportOrder(w http.ResponseWriter, query url.Values) (bool, bool) {
+	switch query.Get("order") {
+	case "", "asc":
+		return false, true
+	case "desc":
+		return true, true
+	default:
+		writeError(w, http.StatusBadRequest, "Invalid parameter value: order")
+		return false, false
+	}
+}
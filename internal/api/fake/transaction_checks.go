@@ -0,0 +1,432 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+// transactionCheckField applies a single request parameter to a transaction
+// check.
+type transactionCheckField func(check *api_types.TransactionCheck, raw json.RawMessage) error
+
+// transactionCheckFields lists the parameters accepted when creating or
+// updating a transaction check.
+var transactionCheckFields = map[string]transactionCheckField{
+	"name": func(check *api_types.TransactionCheck, raw json.RawMessage) error {
+		return decodeNonEmpty(raw, &check.Name)
+	},
+	"active": func(check *api_types.TransactionCheck, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.Active)
+	},
+	"interval": func(check *api_types.TransactionCheck, raw json.RawMessage) error {
+		if err := json.Unmarshal(raw, &check.Interval); err != nil {
+			return err
+		}
+		switch check.Interval {
+		case 5, 10, 20, 60, 720, 1440:
+			return nil
+		default:
+			return fmt.Errorf("must be one of 5, 10, 20, 60, 720 or 1440")
+		}
+	},
+	"region": func(check *api_types.TransactionCheck, raw json.RawMessage) error {
+		if err := json.Unmarshal(raw, &check.Region); err != nil {
+			return err
+		}
+		switch check.Region {
+		case "us-east", "us-west", "eu", "au":
+			return nil
+		default:
+			return fmt.Errorf("must be one of us-east, us-west, eu or au")
+		}
+	},
+	"severity_level": func(check *api_types.TransactionCheck, raw json.RawMessage) error {
+		if err := json.Unmarshal(raw, &check.SeverityLevel); err != nil {
+			return err
+		}
+		if check.SeverityLevel != "high" && check.SeverityLevel != "low" {
+			return fmt.Errorf("must be high or low")
+		}
+		return nil
+	},
+	"send_notification_when_down": func(check *api_types.TransactionCheck, raw json.RawMessage) error {
+		if err := json.Unmarshal(raw, &check.SendNotificationWhenDown); err != nil {
+			return err
+		}
+		if check.SendNotificationWhenDown < 1 {
+			return fmt.Errorf("must be at least 1")
+		}
+		return nil
+	},
+	"custom_message": func(check *api_types.TransactionCheck, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &check.CustomMessage)
+	},
+	"contact_ids": func(check *api_types.TransactionCheck, raw json.RawMessage) error {
+		return decodeIDs(raw, &check.ContactIds)
+	},
+	"team_ids": func(check *api_types.TransactionCheck, raw json.RawMessage) error {
+		return decodeIDs(raw, &check.TeamIds)
+	},
+	"integration_ids": func(check *api_types.TransactionCheck, raw json.RawMessage) error {
+		return decodeIDs(raw, &check.IntegrationIds)
+	},
+	"tags": func(check *api_types.TransactionCheck, raw json.RawMessage) error {
+		var tags []string
+		if err := json.Unmarshal(raw, &tags); err != nil {
+			return err
+		}
+		if tags == nil {
+			tags = []string{}
+		}
+		check.Tags = tags
+		return nil
+	},
+	"steps": func(check *api_types.TransactionCheck, raw json.RawMessage) error {
+		var steps []api_types.TransactionCheckStep
+		if err := json.Unmarshal(raw, &steps); err != nil {
+			return err
+		}
+		if len(steps) == 0 {
+			return fmt.Errorf("at least one step is required")
+		}
+		for i, step := range steps {
+			required, ok := api_types.TransactionCheckStepArgs[step.Fn]
+			if !ok {
+				return fmt.Errorf("step %d: unknown fn %q", i, step.Fn)
+			}
+			for _, arg := range required {
+				if step.Args[arg] == "" {
+					return fmt.Errorf("step %d: missing argument %q", i, arg)
+				}
+			}
+			if steps[i].Args == nil {
+				steps[i].Args = map[string]string{}
+			}
+		}
+		check.Steps = steps
+		return nil
+	},
+	"metadata": func(check *api_types.TransactionCheck, raw json.RawMessage) error {
+		var metadata api_types.TransactionCheckMetadata
+		if err := json.Unmarshal(raw, &metadata); err != nil {
+			return err
+		}
+		if metadata.Width < 0 || metadata.Height < 0 {
+			return fmt.Errorf("width and height must not be negative")
+		}
+		if metadata.Width == 0 {
+			metadata.Width = 1950
+		}
+		if metadata.Height == 0 {
+			metadata.Height = 1080
+		}
+		if metadata.Authentications.HTTPAuthentications == nil {
+			metadata.Authentications.HTTPAuthentications = []api_types.TransactionCheckHTTPAuthentication{}
+		}
+		check.Metadata = metadata
+		return nil
+	},
+}
+
+// AddTransactionCheck stores the transaction check as is, e.g. to add checks
+// in a state which can not be created with the API, and returns its ID.
+func (s *Server) AddTransactionCheck(check api_types.TransactionCheck) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clone := cloneTransactionCheck(&check)
+	clone.Id = s.newID()
+	s.transactionChecks[clone.Id] = &clone
+
+	return clone.Id
+}
+
+// TransactionCheck returns a copy of the transaction check with the given ID.
+func (s *Server) TransactionCheck(id int64) (api_types.TransactionCheck, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.transactionChecks[id]
+	if !ok {
+		return api_types.TransactionCheck{}, false
+	}
+
+	return cloneTransactionCheck(check), true
+}
+
+// UpdateTransactionCheck modifies a transaction check in place, as if it was
+// changed in the Pingdom UI. It reports whether the check exists.
+func (s *Server) UpdateTransactionCheck(id int64, update func(check *api_types.TransactionCheck)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.transactionChecks[id]
+	if ok {
+		update(check)
+	}
+
+	return ok
+}
+
+// DeleteTransactionCheck removes a transaction check, as if it was deleted in
+// the Pingdom UI.
+func (s *Server) DeleteTransactionCheck(id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.transactionChecks, id)
+}
+
+func (s *Server) getTransactionChecks(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := 1000
+	if raw := query.Get("limit"); raw != "" {
+		var err error
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > 1000 {
+			writeError(w, http.StatusBadRequest, "Invalid parameter value: limit")
+			return
+		}
+	}
+
+	offset := 0
+	if raw := query.Get("offset"); raw != "" {
+		var err error
+		offset, err = strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			writeError(w, http.StatusBadRequest, "Invalid parameter value: offset")
+			return
+		}
+	}
+
+	checkType := query.Get("type")
+	if checkType != "" && checkType != "script" && checkType != "recorded" {
+		writeError(w, http.StatusBadRequest, "Invalid parameter value: type")
+		return
+	}
+
+	var tags []string
+	if raw := query.Get("tags"); raw != "" {
+		tags = strings.Split(raw, ",")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checks := []api_types.TransactionCheck{}
+	for _, check := range s.transactionChecks {
+		if checkType != "" && check.Type != checkType {
+			continue
+		}
+		if len(tags) > 0 && !slices.ContainsFunc(check.Tags, func(tag string) bool { return slices.Contains(tags, tag) }) {
+			continue
+		}
+
+		// The list does not include the steps and metadata of the checks.
+		clone := cloneTransactionCheck(check)
+		clone.Steps = nil
+		clone.Metadata = api_types.TransactionCheckMetadata{}
+		checks = append(checks, clone)
+	}
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].Id < checks[j].Id
+	})
+
+	checks = checks[min(offset, len(checks)):]
+	checks = checks[:min(limit, len(checks))]
+
+	writeJSON(w, api_types.TransactionChecks{Checks: checks})
+}
+
+func (s *Server) getTransactionCheck(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.lookupTransactionCheck(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, check)
+}
+
+func (s *Server) createTransactionCheck(w http.ResponseWriter, r *http.Request) {
+	fields, ok := decodeFields(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().Unix()
+	check := &api_types.TransactionCheck{
+		Active:                   true,
+		Type:                     "script",
+		Status:                   "unknown",
+		Interval:                 10,
+		Region:                   "us-east",
+		SeverityLevel:            "high",
+		SendNotificationWhenDown: 1,
+		ContactIds:               []int64{},
+		TeamIds:                  []int64{},
+		IntegrationIds:           []int64{},
+		Tags:                     []string{},
+		Metadata: api_types.TransactionCheckMetadata{
+			Width:  1950,
+			Height: 1080,
+			Authentications: api_types.TransactionCheckAuthentications{
+				HTTPAuthentications: []api_types.TransactionCheckHTTPAuthentication{},
+			},
+		},
+		CreatedAt:  now,
+		ModifiedAt: now,
+	}
+	if err := applyTransactionCheckFields(check, fields, true); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	check.Id = s.newID()
+	s.transactionChecks[check.Id] = check
+
+	writeJSON(w, check)
+}
+
+func (s *Server) updateTransactionCheck(w http.ResponseWriter, r *http.Request) {
+	fields, ok := decodeFields(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.lookupTransactionCheck(w, r)
+	if !ok {
+		return
+	}
+
+	// Apply the changes to a copy, so that invalid requests leave the check
+	// untouched.
+	updated := cloneTransactionCheck(check)
+	if err := applyTransactionCheckFields(&updated, fields, false); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	updated.ModifiedAt = time.Now().Unix()
+	*check = updated
+
+	writeJSON(w, check)
+}
+
+func (s *Server) deleteTransactionCheck(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.lookupTransactionCheck(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.transactionChecks, check.Id)
+
+	writeJSON(w, map[string]any{"message": "Deletion of check was successful!"})
+}
+
+// lookupTransactionCheck returns the transaction check referenced by the path
+// of the request, or writes Pingdom's error response if there is no such check.
+func (s *Server) lookupTransactionCheck(w http.ResponseWriter, r *http.Request) (*api_types.TransactionCheck, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid parameter value: cid")
+		return nil, false
+	}
+
+	check, ok := s.transactionChecks[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Check not found")
+		return nil, false
+	}
+
+	return check, true
+}
+
+// applyTransactionCheckFields applies the parameters of a create or update
+// request to the transaction check.
+func applyTransactionCheckFields(check *api_types.TransactionCheck, fields map[string]json.RawMessage, create bool) error {
+	if create {
+		for _, name := range []string{"name", "steps"} {
+			if _, ok := fields[name]; !ok {
+				return fmt.Errorf("Missing parameter: %s", name)
+			}
+		}
+	}
+
+	// Apply the parameters in a stable order to get deterministic errors.
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		apply, ok := transactionCheckFields[name]
+		if !ok {
+			return fmt.Errorf("Invalid parameter: %s", name)
+		}
+
+		if err := apply(check, fields[name]); err != nil {
+			return fmt.Errorf("Invalid parameter value: %s (%s)", name, err)
+		}
+	}
+
+	return nil
+}
+
+// cloneTransactionCheck returns a copy of the check which shares no slices or
+// maps with it.
+func cloneTransactionCheck(check *api_types.TransactionCheck) api_types.TransactionCheck {
+	clone := *check
+	clone.ContactIds = append([]int64{}, check.ContactIds...)
+	clone.TeamIds = append([]int64{}, check.TeamIds...)
+	clone.IntegrationIds = append([]int64{}, check.IntegrationIds...)
+	clone.Tags = append([]string{}, check.Tags...)
+	clone.Metadata.Authentications.HTTPAuthentications = append(
+		[]api_types.TransactionCheckHTTPAuthentication{},
+		check.Metadata.Authentications.HTTPAuthentications...,
+	)
+
+	clone.Steps = make([]api_types.TransactionCheckStep, len(check.Steps))
+	for i, step := range check.Steps {
+		args := make(map[string]string, len(step.Args))
+		for key, value := range step.Args {
+			args[key] = value
+		}
+		clone.Steps[i] = api_types.TransactionCheckStep{Fn: step.Fn, Args: args}
+	}
+
+	return clone
+}
+
+func decodeIDs(raw json.RawMessage, ids *[]int64) error {
+	var decoded []int64
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return err
+	}
+	if decoded == nil {
+		decoded = []int64{}
+	}
+	*ids = decoded
+
+	return nil
+}
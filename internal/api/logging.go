@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the tflog subsystem used for request and response logging.
+// Its level can be set independently with TF_LOG_PROVIDER_PINGDOM_API.
+const logSubsystem = "api"
+
+const redacted = "***"
+
+// sensitiveKeyPattern matches JSON keys and field keys whose values must never
+// show up in logs, e.g. the password of basic auth checks, the custom request
+// headers of a check which commonly contain API keys, or the arguments of
+// transaction check steps, which include passwords and form values.
+var sensitiveKeyPattern = regexp.MustCompile(`(?i)^(password|auth|authorization|requestheaders?\d*|postdata|args)$`)
+
+// logContext returns a context with the api logging subsystem configured to
+// mask credentials.
+func (client *client) logContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_PINGDOM_API"))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, logSubsystem, "password", "auth", "Authorization")
+	if client.token != "" {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, logSubsystem, client.token)
+		ctx = tflog.SubsystemMaskMessageStrings(ctx, logSubsystem, client.token)
+	}
+
+	return ctx
+}
+
+// logRequest logs the request including its (redacted) headers and body.
+func logRequest(ctx context.Context, req *http.Request) {
+	fields := map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"headers": redactHeaders(req.Header),
+	}
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			content, err := io.ReadAll(body)
+			body.Close()
+			if err == nil && len(content) > 0 {
+				fields["body"] = redactBody(content)
+			}
+		}
+	}
+
+	tflog.SubsystemDebug(ctx, logSubsystem, "Sending Request", fields)
+}
+
+// logResponse logs the response including its (redacted) body.
+func logResponse(ctx context.Context, req *http.Request, res *http.Response, body []byte) {
+	tflog.SubsystemDebug(ctx, logSubsystem, "Received Response", map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"status":  res.StatusCode,
+		"headers": redactHeaders(res.Header),
+		"body":    redactBody(body),
+	})
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for key, values := range header {
+		if sensitiveKeyPattern.MatchString(key) {
+			headers[key] = redacted
+			continue
+		}
+
+		headers[key] = strings.Join(values, ", ")
+	}
+
+	return headers
+}
+
+// redactBody masks the values of sensitive keys anywhere in a JSON body. Bodies
+// which are not JSON are logged as is.
+func redactBody(body []byte) string {
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(redacted)
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			if sensitiveKeyPattern.MatchString(key) {
+				v[key] = redacted
+				continue
+			}
+
+			v[key] = redactValue(nested)
+		}
+	case []any:
+		for i, nested := range v {
+			v[i] = redactValue(nested)
+		}
+	}
+
+	return value
+}
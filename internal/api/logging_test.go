@@ -0,0 +1,53 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	body := `{"check":{"name":"Example","type":{"http":{"username":"admin","password":"s3cret","requestheaders":{"X-Api-Key":"key"}}}},"auth":"admin:s3cret","requestheader1":"X-Api-Key:key"}`
+
+	got := redactBody([]byte(body))
+	for _, secret := range []string{"s3cret", "key\""} {
+		if strings.Contains(got, secret) {
+			t.Errorf("expected %q to be redacted, got %s", secret, got)
+		}
+	}
+	if !strings.Contains(got, `"username":"admin"`) {
+		t.Errorf("expected non-sensitive values to be kept, got %s", got)
+	}
+
+	if got := redactBody([]byte("not json")); got != "not json" {
+		t.Errorf("expected non-JSON body to be kept, got %s", got)
+	}
+}
+
+func TestRedactBody_transactionCheckSteps(t *testing.T) {
+	body := `{"name":"Login","steps":[{"fn":"go_to","args":{"url":"https://example.com"}},{"fn":"fill","args":{"input":"#password","value":"s3cret"}},{"fn":"basic_auth","args":{"username":"admin","password":"hunter2"}}]}`
+
+	got := redactBody([]byte(body))
+	for _, secret := range []string{"s3cret", "hunter2"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("expected %q to be redacted, got %s", secret, got)
+		}
+	}
+	if !strings.Contains(got, `"fn":"fill"`) {
+		t.Errorf("expected the step actions to be kept, got %s", got)
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer token")
+	header.Set("Content-Type", "application/json")
+
+	got := redactHeaders(header)
+	if got["Authorization"] != redacted {
+		t.Errorf("expected Authorization header to be redacted, got %q", got["Authorization"])
+	}
+	if got["Content-Type"] != "application/json" {
+		t.Errorf("expected Content-Type header to be kept, got %q", got["Content-Type"])
+	}
+}
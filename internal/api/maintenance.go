@@ -0,0 +1,200 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// CreateMaintenanceRequest is the body to create or update a maintenance
+// window. All parameters are always sent, so that e.g. an empty list of check
+// IDs removes all checks.
+type CreateMaintenanceRequest struct {
+	Description    string `json:"description"`
+	From           int64  `json:"from"`
+	To             int64  `json:"to"`
+	RecurrenceType string `json:"recurrencetype"`
+	RepeatEvery    int64  `json:"repeatevery"`
+	EffectiveTo    int64  `json:"effectiveto"`
+	// UptimeIds and TmsIds are comma separated lists of check IDs
+	UptimeIds string `json:"uptimeids"`
+	TmsIds    string `json:"tmsids"`
+}
+
+// maintenancePageSize is the number of maintenance windows requested per page.
+var maintenancePageSize = 1000
+
+// GetMaintenanceWindows returns all maintenance windows, requesting as many
+// pages as needed.
+func (client *client) GetMaintenanceWindows(ctx context.Context) ([]api_types.Maintenance, error) {
+	uri, err := url.JoinPath(client.baseURL, "maintenance")
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(maintenancePageSize))
+
+	windows := []api_types.Maintenance{}
+	for offset := 0; ; offset += maintenancePageSize {
+		query.Set("offset", strconv.Itoa(offset))
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri+"?"+query.Encode(), http.NoBody)
+		if err != nil {
+			return nil, err
+		}
+
+		var res *struct {
+			Maintenance []api_types.Maintenance `json:"maintenance"`
+		}
+		err = client.do(req, &res)
+		if err != nil {
+			return nil, err
+		}
+
+		windows = append(windows, res.Maintenance...)
+		if len(res.Maintenance) < maintenancePageSize {
+			return windows, nil
+		}
+	}
+}
+
+func (client *client) GetMaintenance(ctx context.Context, id string) (*api_types.Maintenance, error) {
+	uri, err := url.JoinPath(client.baseURL, "maintenance", id)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *struct {
+		Maintenance api_types.Maintenance `json:"maintenance"`
+	}
+	err = client.do(req, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Maintenance, nil
+}
+
+func (client *client) CreateMaintenance(ctx context.Context, body CreateMaintenanceRequest) (*int64, error) {
+	encodedBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	uri, err := url.JoinPath(client.baseURL, "maintenance")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, bytes.NewReader(encodedBody))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	var res *struct {
+		Maintenance struct {
+			Id int64 `json:"id"`
+		} `json:"maintenance"`
+	}
+	err = client.do(req, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Maintenance.Id, nil
+}
+
+func (client *client) UpdateMaintenance(ctx context.Context, id string, body CreateMaintenanceRequest) error {
+	encodedBody, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	uri, err := url.JoinPath(client.baseURL, "maintenance", id)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri, bytes.NewReader(encodedBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	var res *struct{}
+	return client.do(req, &res)
+}
+
+func (client *client) DeleteMaintenance(ctx context.Context, id string) error {
+	uri, err := url.JoinPath(client.baseURL, "maintenance", id)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	var res *struct{}
+	return client.do(req, &res)
+}
+
+// GetMaintenanceOccurrencesRequest filters the occurrences returned by
+// GetMaintenanceOccurrences. Zero values are not sent.
+type GetMaintenanceOccurrencesRequest struct {
+	MaintenanceId int64
+	// From rules out occurrences which end before this time.
+	From time.Time
+	// To rules out occurrences which start after this time.
+	To time.Time
+}
+
+// GetMaintenanceOccurrences returns the occurrences of the maintenance windows
+// matching the filter, i.e. the single windows of recurring maintenance.
+func (client *client) GetMaintenanceOccurrences(ctx context.Context, filter GetMaintenanceOccurrencesRequest) ([]api_types.MaintenanceOccurrence, error) {
+	uri, err := url.JoinPath(client.baseURL, "maintenance.occurrences")
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if filter.MaintenanceId != 0 {
+		query.Set("maintenanceid", strconv.FormatInt(filter.MaintenanceId, 10))
+	}
+	if !filter.From.IsZero() {
+		query.Set("from", strconv.FormatInt(filter.From.Unix(), 10))
+	}
+	if !filter.To.IsZero() {
+		query.Set("to", strconv.FormatInt(filter.To.Unix(), 10))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri+"?"+query.Encode(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *struct {
+		Occurrences []api_types.MaintenanceOccurrence `json:"occurrences"`
+	}
+	err = client.do(req, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Occurrences, nil
+}
@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// maxConcurrentRequests limits how many requests are in flight at the same
+	// time, independent of how many resources Terraform walks in parallel.
+	maxConcurrentRequests = 4
+
+	// rateLimitLowWatermark is the number of remaining requests in a window
+	// below which requests are spread evenly over the time until the window
+	// resets instead of being sent as fast as possible.
+	rateLimitLowWatermark = 20
+)
+
+// reqLimitPattern matches the value of the Req-Limit-Short and Req-Limit-Long
+// headers, e.g. "Remaining: 394 Time until reset: 3589".
+var reqLimitPattern = regexp.MustCompile(`Remaining:\s*(\d+)\s*Time until reset:\s*(\d+)`)
+
+// rateLimitWindow holds the request budget Pingdom reported for one of its
+// rate limit windows.
+type rateLimitWindow struct {
+	known     bool
+	remaining int64
+	reset     time.Time
+}
+
+// rateLimiter paces the requests of a client based on the budget Pingdom
+// reports with each response. A single limiter is shared by all resources and
+// data sources using the same provider instance.
+type rateLimiter struct {
+	mu    sync.Mutex
+	short rateLimitWindow
+	long  rateLimitWindow
+	next  time.Time
+	slots chan struct{}
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		slots: make(chan struct{}, maxConcurrentRequests),
+	}
+}
+
+// acquire blocks until a request may be sent. The returned function must be
+// called once the response has been consumed.
+func (l *rateLimiter) acquire(ctx context.Context) (func(), error) {
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	release := func() { <-l.slots }
+
+	for {
+		wait := l.reserve()
+		if wait <= 0 {
+			return release, nil
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			release()
+			return nil, err
+		}
+	}
+}
+
+// reserve takes one request from the budget and returns 0, or returns how
+// long to wait if no request may be sent right now.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	var wait time.Duration
+	for _, window := range []*rateLimitWindow{&l.short, &l.long} {
+		if window.known && window.remaining <= 0 && now.Before(window.reset) {
+			wait = max(wait, window.reset.Sub(now))
+		}
+	}
+	if now.Before(l.next) {
+		wait = max(wait, l.next.Sub(now))
+	}
+	if wait > 0 {
+		return wait
+	}
+
+	var interval time.Duration
+	for _, window := range []*rateLimitWindow{&l.short, &l.long} {
+		if !window.known || !now.Before(window.reset) {
+			window.known = false
+			continue
+		}
+
+		window.remaining--
+		if window.remaining < rateLimitLowWatermark {
+			interval = max(interval, window.reset.Sub(now)/time.Duration(window.remaining+2))
+		}
+	}
+	l.next = now.Add(interval)
+
+	return 0
+}
+
+// update records the budget reported in the headers of the response.
+func (l *rateLimiter) update(res *http.Response) {
+	if res == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if window, ok := parseReqLimit(res.Header.Get("Req-Limit-Short"), now); ok {
+		l.short = window
+	}
+	if window, ok := parseReqLimit(res.Header.Get("Req-Limit-Long"), now); ok {
+		l.long = window
+	}
+}
+
+func parseReqLimit(value string, now time.Time) (rateLimitWindow, bool) {
+	matches := reqLimitPattern.FindStringSubmatch(value)
+	if matches == nil {
+		return rateLimitWindow{}, false
+	}
+
+	remaining, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return rateLimitWindow{}, false
+	}
+
+	seconds, err := strconv.ParseInt(matches[2], 10, 64)
+	if err != nil {
+		return rateLimitWindow{}, false
+	}
+
+	return rateLimitWindow{
+		known:     true,
+		remaining: remaining,
+		reset:     now.Add(time.Duration(seconds) * time.Second),
+	}, true
+}
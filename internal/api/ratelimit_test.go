@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_reserve(t *testing.T) {
+	tests := []struct {
+		name    string
+		short   rateLimitWindow
+		long    rateLimitWindow
+		calls   int
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{
+			name:  "unknown budget is not paced",
+			calls: 50,
+		},
+		{
+			name:  "budget above the watermark is not paced",
+			short: rateLimitWindow{known: true, remaining: 100, reset: time.Now().Add(time.Minute)},
+			calls: 50,
+		},
+		{
+			name:    "exhausted window blocks until reset",
+			short:   rateLimitWindow{known: true, remaining: 0, reset: time.Now().Add(time.Minute)},
+			wantMin: 59 * time.Second,
+			wantMax: time.Minute,
+		},
+		{
+			name:    "the longer wait of both windows is used",
+			short:   rateLimitWindow{known: true, remaining: 0, reset: time.Now().Add(time.Minute)},
+			long:    rateLimitWindow{known: true, remaining: 0, reset: time.Now().Add(time.Hour)},
+			wantMin: 59 * time.Minute,
+			wantMax: time.Hour,
+		},
+		{
+			name:  "expired window is ignored",
+			short: rateLimitWindow{known: true, remaining: 0, reset: time.Now().Add(-time.Second)},
+			calls: 50,
+		},
+		{
+			// After the first request 4 requests remain, so the next one is
+			// delayed by a sixth of the minute until the reset.
+			name:    "requests below the watermark are spread until reset",
+			short:   rateLimitWindow{known: true, remaining: 5, reset: time.Now().Add(time.Minute)},
+			calls:   1,
+			wantMin: 9 * time.Second,
+			wantMax: 10 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newRateLimiter()
+			l.short = tt.short
+			l.long = tt.long
+
+			for i := 0; i < tt.calls; i++ {
+				if wait := l.reserve(); wait != 0 {
+					t.Fatalf("expected request %d not to wait, got %s", i, wait)
+				}
+			}
+
+			if tt.wantMax == 0 {
+				return
+			}
+			if wait := l.reserve(); wait < tt.wantMin || wait > tt.wantMax {
+				t.Errorf("expected request %d to wait between %s and %s, got %s", tt.calls, tt.wantMin, tt.wantMax, wait)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_acquireConcurrency(t *testing.T) {
+	l := newRateLimiter()
+
+	var releases []func()
+	for i := 0; i < maxConcurrentRequests; i++ {
+		release, err := l.acquire(context.Background())
+		if err != nil {
+			t.Fatalf("acquire %d: %s", i, err)
+		}
+		releases = append(releases, release)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected acquire to block while %d requests are in flight, got %v", maxConcurrentRequests, err)
+	}
+
+	releases[0]()
+	release, err := l.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("expected acquire to succeed after a release, got %s", err)
+	}
+	release()
+}
+
+func TestRateLimiter_acquireCancel(t *testing.T) {
+	l := newRateLimiter()
+	l.short = rateLimitWindow{known: true, remaining: 0, reset: time.Now().Add(time.Hour)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	if _, err := l.acquire(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected acquire to be canceled, got %v", err)
+	}
+	if n := len(l.slots); n != 0 {
+		t.Errorf("expected the slot to be released, %d still taken", n)
+	}
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a failed request is retried
+	// when no other value has been configured.
+	DefaultMaxRetries = 4
+
+	defaultRetryWaitMin = 1 * time.Second
+	defaultRetryWaitMax = 30 * time.Second
+)
+
+// isIdempotent reports whether the request can be sent again without the
+// risk of applying its side effects twice.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// shouldRetry decides whether a request should be attempted again based on
+// the outcome of the previous attempt. Requests which are not idempotent
+// (i.e. POST) are only retried if they were throttled (429), as any other
+// error, including a 503 of a load balancer, doesn't guarantee that the
+// request wasn't processed, and a check must never be created twice.
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		// The request may or may not have reached Pingdom.
+		return isIdempotent(req)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req)
+	default:
+		return false
+	}
+}
+
+// retryAfter parses the Retry-After header, which is either given in seconds
+// or as an HTTP date.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	value := res.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// backoff returns how long to wait before the given retry attempt (starting
+// at 1). The server's Retry-After header takes precedence, otherwise an
+// exponential backoff with full jitter is used. The wait never exceeds
+// retryWaitMax, so a misbehaving server can't stall the provider.
+func (client *client) backoff(attempt int, res *http.Response) time.Duration {
+	if wait, ok := retryAfter(res); ok {
+		return min(wait, client.retryWaitMax)
+	}
+
+	wait := client.retryWaitMax
+	if attempt < 32 {
+		if exp := client.retryWaitMin << (attempt - 1); exp > 0 && exp < client.retryWaitMax {
+			wait = exp
+		}
+	}
+
+	if wait <= 0 {
+		return 0
+	}
+
+	return min(time.Duration(rand.Int64N(int64(wait)))+client.retryWaitMin/2, client.retryWaitMax)
+}
+
+// sleep blocks for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestShouldRetry(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		status int
+		err    error
+		want   bool
+	}{
+		{name: "GET on 429", method: http.MethodGet, status: http.StatusTooManyRequests, want: true},
+		{name: "GET on 502", method: http.MethodGet, status: http.StatusBadGateway, want: true},
+		{name: "GET on network error", method: http.MethodGet, err: errors.New("connection reset"), want: true},
+		{name: "GET on 400", method: http.MethodGet, status: http.StatusBadRequest, want: false},
+		{name: "POST on 429", method: http.MethodPost, status: http.StatusTooManyRequests, want: true},
+		{name: "POST on 502", method: http.MethodPost, status: http.StatusBadGateway, want: false},
+		{name: "POST on 503", method: http.MethodPost, status: http.StatusServiceUnavailable, want: false},
+		{name: "POST on network error", method: http.MethodPost, err: errors.New("connection reset"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "https://api.pingdom.com/api/3.1/checks", nil)
+
+			var res *http.Response
+			if tt.err == nil {
+				res = &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			}
+
+			if got := shouldRetry(req, res, tt.err); got != tt.want {
+				t.Errorf("expected %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_backoff(t *testing.T) {
+	c := &client{retryWaitMin: time.Second, retryWaitMax: 30 * time.Second}
+
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("Retry-After", "3600")
+	if wait := c.backoff(1, res); wait != c.retryWaitMax {
+		t.Errorf("expected Retry-After to be capped at %s, got %s", c.retryWaitMax, wait)
+	}
+
+	res.Header.Set("Retry-After", "7")
+	if wait := c.backoff(1, res); wait != 7*time.Second {
+		t.Errorf("expected 7s, got %s", wait)
+	}
+
+	for attempt := 1; attempt <= 40; attempt++ {
+		if wait := c.backoff(attempt, nil); wait < 0 || wait > c.retryWaitMax {
+			t.Errorf("expected wait of attempt %d to be within [0, %s], got %s", attempt, c.retryWaitMax, wait)
+		}
+	}
+}
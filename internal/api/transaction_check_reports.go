@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetTransactionCheckPerformanceReportRequest selects the range and the
+// resolution of a performance report.
+type GetTransactionCheckPerformanceReportRequest struct {
+	From time.Time
+	To   time.Time
+	// Resolution of the intervals, one of "hour", "day" or "week"
+	Resolution string
+}
+
+// GetTransactionCheckPerformanceReport returns the average response times and
+// the uptime of a transaction check per interval, in ascending order.
+func (client *client) GetTransactionCheckPerformanceReport(ctx context.Context, id string, filter GetTransactionCheckPerformanceReportRequest) (*api_types.TransactionCheckPerformanceReport, error) {
+	uri, err := url.JoinPath(client.baseURL, "tms/check", id, "report/performance")
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("from", filter.From.UTC().Format(time.RFC3339))
+	query.Set("to", filter.To.UTC().Format(time.RFC3339))
+	query.Set("resolution", filter.Resolution)
+	query.Set("include_uptime", "true")
+	query.Set("order", "asc")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri+"?"+query.Encode(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *struct {
+		Report api_types.TransactionCheckPerformanceReport `json:"report"`
+	}
+	err = client.do(req, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Report, nil
+}
+
+// GetTransactionCheckStatusReportsRequest selects the range and filters the
+// transaction checks of the status reports.
+type GetTransactionCheckStatusReportsRequest struct {
+	From time.Time
+	To   time.Time
+	// Tags limits the result to checks with at least one of the tags.
+	Tags []string
+	// Type limits the result to checks of this type, "script" or "recorded".
+	Type string
+}
+
+// GetTransactionCheckStatusReports returns the status periods of all
+// transaction checks matching the filter, requesting as many pages as needed.
+func (client *client) GetTransactionCheckStatusReports(ctx context.Context, filter GetTransactionCheckStatusReportsRequest) ([]api_types.TransactionCheckStatusReport, error) {
+	uri, err := url.JoinPath(client.baseURL, "tms/check/report/status")
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("from", filter.From.UTC().Format(time.RFC3339))
+	query.Set("to", filter.To.UTC().Format(time.RFC3339))
+	query.Set("order", "asc")
+	query.Set("limit", strconv.Itoa(transactionChecksPageSize))
+	if len(filter.Tags) > 0 {
+		query.Set("tags", strings.Join(filter.Tags, ","))
+	}
+	if filter.Type != "" {
+		query.Set("type", filter.Type)
+	}
+
+	reports := []api_types.TransactionCheckStatusReport{}
+	for offset := 0; ; offset += transactionChecksPageSize {
+		query.Set("offset", strconv.Itoa(offset))
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri+"?"+query.Encode(), http.NoBody)
+		if err != nil {
+			return nil, err
+		}
+
+		var res *struct {
+			Report []api_types.TransactionCheckStatusReport `json:"report"`
+		}
+		err = client.do(req, &res)
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, res.Report...)
+		if len(res.Report) < transactionChecksPageSize {
+			return reports, nil
+		}
+	}
+}
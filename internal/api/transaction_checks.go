@@ -0,0 +1,158 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// CreateTransactionCheckRequest is the body to create or update a transaction
+// check. All attributes are always sent, as Pingdom resets omitted attributes
+// to their default.
+type CreateTransactionCheckRequest struct {
+	Name                     string                             `json:"name"`
+	Active                   bool                               `json:"active"`
+	Interval                 int64                              `json:"interval"`
+	Region                   string                             `json:"region"`
+	SeverityLevel            string                             `json:"severity_level"`
+	SendNotificationWhenDown int64                              `json:"send_notification_when_down"`
+	ContactIds               []int64                            `json:"contact_ids"`
+	TeamIds                  []int64                            `json:"team_ids"`
+	Tags                     []string                           `json:"tags"`
+	Steps                    []api_types.TransactionCheckStep   `json:"steps"`
+	Metadata                 api_types.TransactionCheckMetadata `json:"metadata"`
+}
+
+// GetTransactionChecksRequest filters the transaction checks returned by
+// GetTransactionChecks.
+type GetTransactionChecksRequest struct {
+	// Tags limits the result to checks with at least one of the tags.
+	Tags []string
+	// Type limits the result to checks of this type, "script" or "recorded".
+	Type string
+}
+
+// transactionChecksPageSize is the number of transaction checks requested per
+// page, which is the maximum allowed by Pingdom.
+var transactionChecksPageSize = 1000
+
+// GetTransactionChecks returns all transaction checks matching the filter,
+// requesting as many pages as needed.
+func (client *client) GetTransactionChecks(ctx context.Context, filter GetTransactionChecksRequest) ([]api_types.TransactionCheck, error) {
+	uri, err := url.JoinPath(client.baseURL, "tms/check")
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(transactionChecksPageSize))
+	if len(filter.Tags) > 0 {
+		query.Set("tags", strings.Join(filter.Tags, ","))
+	}
+	if filter.Type != "" {
+		query.Set("type", filter.Type)
+	}
+
+	checks := []api_types.TransactionCheck{}
+	for offset := 0; ; offset += transactionChecksPageSize {
+		query.Set("offset", strconv.Itoa(offset))
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri+"?"+query.Encode(), http.NoBody)
+		if err != nil {
+			return nil, err
+		}
+
+		var res *api_types.TransactionChecks
+		err = client.do(req, &res)
+		if err != nil {
+			return nil, err
+		}
+
+		checks = append(checks, res.Checks...)
+		if len(res.Checks) < transactionChecksPageSize {
+			return checks, nil
+		}
+	}
+}
+
+func (client *client) GetTransactionCheck(ctx context.Context, id string) (*api_types.TransactionCheck, error) {
+	uri, err := url.JoinPath(client.baseURL, "tms/check", id)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *api_types.TransactionCheck
+	err = client.do(req, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (client *client) CreateTransactionCheck(ctx context.Context, body CreateTransactionCheckRequest) (*api_types.TransactionCheck, error) {
+	uri, err := url.JoinPath(client.baseURL, "tms/check")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.sendTransactionCheck(ctx, http.MethodPost, uri, body)
+}
+
+func (client *client) UpdateTransactionCheck(ctx context.Context, id string, body CreateTransactionCheckRequest) (*api_types.TransactionCheck, error) {
+	uri, err := url.JoinPath(client.baseURL, "tms/check", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.sendTransactionCheck(ctx, http.MethodPut, uri, body)
+}
+
+// sendTransactionCheck sends the body and returns the check as stored by
+// Pingdom, which is included in the response of create and update requests.
+func (client *client) sendTransactionCheck(ctx context.Context, method, uri string, body CreateTransactionCheckRequest) (*api_types.TransactionCheck, error) {
+	encodedBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, uri, bytes.NewReader(encodedBody))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	var res *api_types.TransactionCheck
+	err = client.do(req, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (client *client) DeleteTransactionCheck(ctx context.Context, id string) error {
+	uri, err := url.JoinPath(client.baseURL, "tms/check", id)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	var res *struct{}
+	return client.do(req, &res)
+}
@@ -25,8 +25,62 @@ type Check struct {
 	UserIDs                  []int64       `json:"userids"`
 }
 
+// CheckTypes holds the options specific to the type of a check. Pingdom only
+// returns the block matching the type, so exactly one of the fields is set.
 type CheckTypes struct {
-	HTTP CheckHTTPOptions `json:"http"`
+	HTTP *CheckHTTPOptions `json:"http,omitempty"`
+	TCP  *CheckTCPOptions  `json:"tcp,omitempty"`
+	Ping *CheckPingOptions `json:"ping,omitempty"`
+	DNS  *CheckDNSOptions  `json:"dns,omitempty"`
+	UDP  *CheckUDPOptions  `json:"udp,omitempty"`
+	SMTP *CheckSMTPOptions `json:"smtp,omitempty"`
+	POP3 *CheckPOP3Options `json:"pop3,omitempty"`
+	IMAP *CheckIMAPOptions `json:"imap,omitempty"`
+
+	HTTPCustom *CheckHTTPCustomOptions `json:"httpcustom,omitempty"`
+}
+
+// Name returns the type of the check as used by Pingdom, e.g. "http".
+func (types CheckTypes) Name() string {
+	switch {
+	case types.HTTP != nil:
+		return "http"
+	case types.TCP != nil:
+		return "tcp"
+	case types.Ping != nil:
+		return "ping"
+	case types.DNS != nil:
+		return "dns"
+	case types.UDP != nil:
+		return "udp"
+	case types.SMTP != nil:
+		return "smtp"
+	case types.POP3 != nil:
+		return "pop3"
+	case types.IMAP != nil:
+		return "imap"
+	case types.HTTPCustom != nil:
+		return "httpcustom"
+	default:
+		return ""
+	}
+}
+
+// Checks is a page of the uptime checks in the organization.
+type Checks struct {
+	Checks []CheckSummary `json:"checks"`
+}
+
+// CheckSummary is a check as returned by the list of checks, which only
+// contains the most important attributes. Unlike in Check, the type is just
+// its name, e.g. "http".
+type CheckSummary struct {
+	Id       int64      `json:"id"`
+	Name     string     `json:"name"`
+	Type     string     `json:"type"`
+	Hostname string     `json:"hostname"`
+	Status   string     `json:"status"`
+	Tags     []CheckTag `json:"tags"`
 }
 
 type CheckTag struct {
@@ -35,6 +89,10 @@ type CheckTag struct {
 	Count string `json:"count"`
 }
 
+// DefaultUserAgent is the User-Agent header Pingdom adds to the request
+// headers of every HTTP check unless it is set explicitly.
+const DefaultUserAgent = "Pingdom.com_bot_version_1.4_(http://www.pingdom.com/)"
+
 type CheckHTTPOptions struct {
 	VerifyCertificate bool              `json:"verify_certificate"`
 	URL               string            `json:"url"`
@@ -44,4 +102,53 @@ type CheckHTTPOptions struct {
 	SSLDownDaysBefore int64             `json:"ssl_down_days_before"`
 	Username          string            `json:"username"`
 	Password          string            `json:"password"`
+	ShouldContain     string            `json:"shouldcontain"`
+	ShouldNotContain  string            `json:"shouldnotcontain"`
+	PostData          string            `json:"postdata"`
+}
+
+type CheckTCPOptions struct {
+	Port           int64  `json:"port"`
+	StringToSend   string `json:"stringtosend"`
+	StringToExpect string `json:"stringtoexpect"`
+}
+
+// CheckPingOptions is empty, as ping checks have no options besides the ones
+// shared by all checks.
+type CheckPingOptions struct{}
+
+type CheckDNSOptions struct {
+	NameServer string `json:"nameserver"`
+	ExpectedIP string `json:"expectedip"`
+}
+
+type CheckUDPOptions struct {
+	Port           int64  `json:"port"`
+	StringToSend   string `json:"stringtosend"`
+	StringToExpect string `json:"stringtoexpect"`
+}
+
+type CheckSMTPOptions struct {
+	Port           int64  `json:"port"`
+	Username       string `json:"username"`
+	Password       string `json:"password"`
+	StringToExpect string `json:"stringtoexpect"`
+	Encryption     bool   `json:"encryption"`
+}
+
+type CheckPOP3Options struct {
+	Port           int64  `json:"port"`
+	StringToExpect string `json:"stringtoexpect"`
+}
+
+type CheckIMAPOptions struct {
+	Port           int64  `json:"port"`
+	StringToExpect string `json:"stringtoexpect"`
+}
+
+type CheckHTTPCustomOptions struct {
+	URL            string   `json:"url"`
+	Encryption     bool     `json:"encryption"`
+	Port           int64    `json:"port"`
+	AdditionalURLs []string `json:"additionalurls"`
 }
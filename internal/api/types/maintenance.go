@@ -0,0 +1,33 @@
+package api_types
+
+type Maintenance struct {
+	Id          int64  `json:"id"`
+	Description string `json:"description"`
+	// From and To are the start and the end of the first window in seconds
+	// since the epoch
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+	// RecurrenceType is one of "none", "day", "week" or "month"
+	RecurrenceType string `json:"recurrencetype"`
+	// RepeatEvery is the number of days, weeks or months between the windows
+	RepeatEvery int64 `json:"repeatevery"`
+	// EffectiveTo is the end of the recurrence in seconds since the epoch
+	EffectiveTo int64             `json:"effectiveto"`
+	Checks      MaintenanceChecks `json:"checks"`
+}
+
+type MaintenanceChecks struct {
+	// Uptime contains the IDs of the uptime checks
+	Uptime []int64 `json:"uptime"`
+	// TMS contains the IDs of the transaction checks
+	TMS []int64 `json:"tms"`
+}
+
+type MaintenanceOccurrence struct {
+	Id            int64 `json:"id"`
+	MaintenanceId int64 `json:"maintenanceid"`
+	// From and To are the start and the end of the occurrence in seconds since
+	// the epoch
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
@@ -0,0 +1,90 @@
+package api_types
+
+type TransactionCheck struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+	// Active is false if the check is paused
+	Active bool `json:"active"`
+	// Type is either "script" or "recorded"
+	Type   string `json:"type"`
+	Status string `json:"status"`
+	// Interval between the runs of the check in minutes
+	Interval int64 `json:"interval"`
+	// Region of the probes, one of "us-east", "us-west", "eu" or "au"
+	Region string `json:"region"`
+	// SeverityLevel of the alerts, either "high" or "low"
+	SeverityLevel            string                   `json:"severity_level"`
+	SendNotificationWhenDown int64                    `json:"send_notification_when_down"`
+	CustomMessage            string                   `json:"custom_message"`
+	ContactIds               []int64                  `json:"contact_ids"`
+	TeamIds                  []int64                  `json:"team_ids"`
+	IntegrationIds           []int64                  `json:"integration_ids"`
+	Tags                     []string                 `json:"tags"`
+	Steps                    []TransactionCheckStep   `json:"steps"`
+	Metadata                 TransactionCheckMetadata `json:"metadata"`
+	CreatedAt                int64                    `json:"created_at"`
+	ModifiedAt               int64                    `json:"modified_at"`
+	LastDowntimeStart        int64                    `json:"last_downtime_start"`
+	LastDowntimeEnd          int64                    `json:"last_downtime_end"`
+}
+
+type TransactionCheckStep struct {
+	// Fn is the action of the step, e.g. "go_to" or "click"
+	Fn   string            `json:"fn"`
+	Args map[string]string `json:"args"`
+}
+
+type TransactionCheckMetadata struct {
+	// Width and Height of the browser window in pixels
+	Width              int64                           `json:"width"`
+	Height             int64                           `json:"height"`
+	DisableWebSecurity bool                            `json:"disableWebSecurity"`
+	Authentications    TransactionCheckAuthentications `json:"authentications"`
+}
+
+type TransactionCheckAuthentications struct {
+	HTTPAuthentications []TransactionCheckHTTPAuthentication `json:"httpAuthentications"`
+}
+
+// TransactionCheckHTTPAuthentication holds the basic auth credentials the
+// browser uses for the host.
+type TransactionCheckHTTPAuthentication struct {
+	Host     string `json:"host"`
+	UserName string `json:"userName"`
+	Password string `json:"password"`
+}
+
+// TransactionCheckStepArgs lists the step actions supported by Pingdom with the
+// arguments each of them requires.
+var TransactionCheckStepArgs = map[string][]string{
+	"go_to":                 {"url"},
+	"url":                   {"url"},
+	"click":                 {"element"},
+	"submit":                {"form"},
+	"fill":                  {"input", "value"},
+	"check":                 {"checkbox"},
+	"uncheck":               {"checkbox"},
+	"select":                {"select", "option"},
+	"select_radio":          {"radio"},
+	"basic_auth":            {"username", "password"},
+	"sleep":                 {"seconds"},
+	"wait_for_element":      {"element"},
+	"wait_for_contains":     {"element", "value"},
+	"exists":                {"element"},
+	"not_exists":            {"element"},
+	"contains_text":         {"element", "value"},
+	"not_contains_text":     {"element", "value"},
+	"field_contains":        {"input", "value"},
+	"field_not_contains":    {"input", "value"},
+	"is_checked":            {"checkbox"},
+	"is_not_checked":        {"checkbox"},
+	"radio_is_selected":     {"radio"},
+	"dropdown_selected":     {"select", "option"},
+	"dropdown_not_selected": {"select", "option"},
+}
+
+type TransactionChecks struct {
+	// A page of the transaction checks in the organization. The steps and
+	// metadata are not included.
+	Checks []TransactionCheck `json:"checks"`
+}
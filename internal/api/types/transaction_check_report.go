@@ -0,0 +1,46 @@
+package api_types
+
+import "time"
+
+type TransactionCheckPerformanceReport struct {
+	CheckId int64  `json:"check_id"`
+	Name    string `json:"name"`
+	// Resolution of the intervals, one of "hour", "day" or "week"
+	Resolution string                                `json:"resolution"`
+	Intervals  []TransactionCheckPerformanceInterval `json:"intervals"`
+}
+
+type TransactionCheckPerformanceInterval struct {
+	// From is the start of the interval
+	From time.Time `json:"from"`
+	// AverageResponse time of the whole transaction in milliseconds
+	AverageResponse int64 `json:"average_response"`
+	// Uptime, Downtime and Unmonitored time within the interval in seconds
+	Uptime      int64                             `json:"uptime"`
+	Downtime    int64                             `json:"downtime"`
+	Unmonitored int64                             `json:"unmonitored"`
+	Steps       []TransactionCheckStepPerformance `json:"steps"`
+}
+
+type TransactionCheckStepPerformance struct {
+	// AverageResponse time of the step in milliseconds
+	AverageResponse int64                `json:"average_response"`
+	Step            TransactionCheckStep `json:"step"`
+}
+
+type TransactionCheckStatusReport struct {
+	CheckId int64                   `json:"check_id"`
+	Name    string                  `json:"name"`
+	States  []TransactionCheckState `json:"states"`
+}
+
+type TransactionCheckState struct {
+	// Status of the check, e.g. "successful", "failing" or "unknown"
+	Status string    `json:"status"`
+	From   time.Time `json:"from"`
+	To     time.Time `json:"to"`
+	// Message describing the failure
+	Message string `json:"message"`
+	// ErrorInStep is the index of the failed step
+	ErrorInStep int64 `json:"error_in_step"`
+}
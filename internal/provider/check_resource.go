@@ -0,0 +1,554 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// checkResource implements the parts which are the same for all uptime check
+// resources, regardless of the check type.
+type checkResource struct {
+	client api.Client
+}
+
+// CheckModel holds the attributes shared by all uptime check resources. It is
+// embedded into the model of each check resource.
+type CheckModel struct {
+	Id     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Paused types.Bool   `tfsdk:"paused"`
+
+	Host types.String `tfsdk:"host"`
+	IPv6 types.Bool   `tfsdk:"ipv6"`
+
+	Frequency  types.String `tfsdk:"frequency"`
+	Message    types.String `tfsdk:"message"`
+	ContactIds types.Set    `tfsdk:"contact_ids"`
+	// Triggers a down alert if the response time exceeds threshold specified in ms.
+	ResponseTimeThreshold types.Int64 `tfsdk:"response_time_threshold"`
+	// Send notification when down X times
+	NotifyWhenDown types.Int64 `tfsdk:"notify_when_down"`
+	// Notify again every n result. 0 means that no extra notifications will be sent.
+	NotifyAgainEvery types.Int64 `tfsdk:"notify_again_every"`
+	// Notify when back up again
+	NotifyWhenBackUp types.Bool `tfsdk:"notify_when_back_up"`
+
+	Regions types.Set `tfsdk:"regions"`
+
+	Tags types.Map `tfsdk:"tags"`
+}
+
+// CheckAuthModel holds the credentials of checks which support authentication.
+type CheckAuthModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	// PasswordWO is write-only and therefore only set in the configuration.
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+// checkSchemaAttributes returns the attributes shared by all uptime check
+// resources merged with the given attributes specific to the check type.
+func checkSchemaAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	merged := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the check in Pingdom.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the check.",
+			Required:            true,
+		},
+		"paused": schema.BoolAttribute{
+			MarkdownDescription: "Whether the check is paused.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+
+		"host": schema.StringAttribute{
+			MarkdownDescription: "The host of the check.",
+			Required:            true,
+		},
+		"ipv6": schema.BoolAttribute{
+			MarkdownDescription: "Whether the check is performed over IPv6. IPv6 probes are only available in the EU and NA regions. The default value is false.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+
+		"frequency": schema.StringAttribute{
+			MarkdownDescription: "Define how frequent the check should run. Allowed values are: 1m, 5m, 15m, 30m and 60m.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("1m", "5m", "15m", "30m", "60m"),
+			},
+			Default: stringdefault.StaticString("5m"),
+		},
+		"message": schema.StringAttribute{
+			MarkdownDescription: "A custom message for the check to be send in the notifications.",
+			Optional:            true,
+		},
+		"contact_ids": schema.SetAttribute{
+			MarkdownDescription: "A list of contact IDs that will be notified.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+		},
+		"response_time_threshold": schema.Int64Attribute{
+			MarkdownDescription: "Triggers a downtime if the response time exceeds this threshold (in ms). The default value is 30s (30000ms).",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(30000),
+		},
+		"notify_when_down": schema.Int64Attribute{
+			MarkdownDescription: "Notify the contacts when the check is down for X times. The default value is 2.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(2),
+		},
+		"notify_again_every": schema.Int64Attribute{
+			MarkdownDescription: "Notify the contacts again when the check continues to be down after X times. The default value is 0.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+		},
+		"notify_when_back_up": schema.BoolAttribute{
+			MarkdownDescription: "Notify the contacts when the check is back-up.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+
+		"regions": schema.SetAttribute{
+			MarkdownDescription: "A list of regions from which the check will be performed.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(
+					stringvalidator.OneOf("EU", "NA", "APAC", "LATAM"),
+				),
+			},
+		},
+
+		"tags": schema.MapAttribute{
+			MarkdownDescription: "A list of tags for the check.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+		},
+	}
+
+	for name, attribute := range attributes {
+		merged[name] = attribute
+	}
+
+	return merged
+}
+
+// checkAuthSchemaAttribute returns the schema of the auth attribute of checks
+// which support authentication.
+func checkAuthSchemaAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username used to authenticate.",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password used to authenticate. The password is stored in the Terraform state, use `password_wo` to avoid this. " +
+					"Exactly one of `password` and `password_wo` must be set.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "The password used to authenticate as a write-only attribute, which is never stored in the Terraform state. " +
+					"Requires Terraform 1.11 or later. Increment `password_wo_version` to update the password.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `password_wo`. The password is only sent to Pingdom on create or when this version or the `username` changes.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+				},
+			},
+		},
+	}
+}
+
+// validateCheckConfig validates the shared attributes of the configuration.
+func validateCheckConfig(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) {
+	var ipv6 types.Bool
+	var regions types.Set
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("ipv6"), &ipv6)...)
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("regions"), &regions)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	if ipv6.ValueBool() {
+		validateIPv6Regions(regions, diagnostics)
+	}
+}
+
+// ipv6Regions are the regions which offer IPv6 probes.
+var ipv6Regions = map[string]bool{"EU": true, "NA": true}
+
+// validateIPv6Regions adds an error for every configured region which has no
+// IPv6 probes.
+func validateIPv6Regions(regions types.Set, diagnostics *diag.Diagnostics) {
+	for _, region := range regions.Elements() {
+		stringValue, ok := region.(types.String)
+		if !ok || stringValue.IsUnknown() || ipv6Regions[stringValue.ValueString()] {
+			continue
+		}
+
+		diagnostics.AddAttributeError(
+			path.Root("regions"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The region %s does not offer IPv6 probes. With ipv6 enabled only the regions EU and NA can be used.", stringValue.ValueString()),
+		)
+	}
+}
+
+// transformPingdomCheckToCheckModel converts the attributes shared by all
+// check types.
+func transformPingdomCheckToCheckModel(check api_types.Check) (CheckModel, diag.Diagnostics) {
+	var contactIds []attr.Value
+	for _, userId := range check.UserIDs {
+		contactIds = append(contactIds, types.StringValue(strconv.FormatInt(userId, 10)))
+	}
+
+	tags := map[string]attr.Value{}
+	for _, tag := range check.Tags {
+		s := strings.Split(tag.Name, ":")
+		if len(s) != 2 {
+			continue
+		}
+
+		tags[s[0]] = types.StringValue(s[1])
+	}
+
+	var regions []attr.Value
+	for _, filter := range check.ProbeFilters {
+		if !strings.HasPrefix(filter, "region: ") {
+			continue
+		}
+
+		regions = append(regions, types.StringValue(strings.ReplaceAll(filter, "region: ", "")))
+	}
+
+	tfContactIds, diagnostics := types.SetValue(types.StringType, contactIds)
+	if diagnostics.HasError() {
+		return CheckModel{}, diagnostics
+	}
+
+	tfTags, diagnostics := types.MapValue(types.StringType, tags)
+	if diagnostics.HasError() {
+		return CheckModel{}, diagnostics
+	}
+
+	tfRegions, diagnostics := types.SetValue(types.StringType, regions)
+	if diagnostics.HasError() {
+		return CheckModel{}, diagnostics
+	}
+
+	message := types.StringNull()
+	if check.CustomMessage != "" {
+		message = types.StringValue(check.CustomMessage)
+	}
+
+	return CheckModel{
+		Id:     types.StringValue(strconv.FormatInt(check.Id, 10)),
+		Name:   types.StringValue(check.Name),
+		Paused: types.BoolValue(check.Status == "paused"),
+
+		Host: types.StringValue(check.Hostname),
+		IPv6: types.BoolValue(check.Ipv6),
+
+		Frequency:             types.StringValue(fmt.Sprintf("%dm", check.Resolution)),
+		Message:               message,
+		ContactIds:            tfContactIds,
+		ResponseTimeThreshold: types.Int64Value(check.ResponseTimeThreshold),
+		NotifyWhenDown:        types.Int64Value(check.SendNotificationWhenDown),
+		NotifyAgainEvery:      types.Int64Value(check.NotifyAgainEvery),
+		NotifyWhenBackUp:      types.BoolValue(check.NotifyWhenBackup),
+
+		Regions: tfRegions,
+
+		Tags: tfTags,
+	}, nil
+}
+
+// transformPingdomAuthToModel converts the credentials returned by Pingdom. The
+// prior auth configuration from the plan or state is used to keep the password
+// settings which can not be read from Pingdom.
+func transformPingdomAuthToModel(username, password string, priorAuth *CheckAuthModel) *CheckAuthModel {
+	if username == "" {
+		return nil
+	}
+
+	auth := &CheckAuthModel{
+		Username:          types.StringValue(username),
+		Password:          types.StringNull(),
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: types.Int64Null(),
+	}
+
+	switch {
+	case priorAuth != nil && priorAuth.Password.IsNull():
+		// The password is managed write-only and must not end up in state.
+		auth.PasswordWOVersion = priorAuth.PasswordWOVersion
+	case password != "":
+		auth.Password = types.StringValue(password)
+	case priorAuth != nil:
+		// Pingdom does not always return the password.
+		auth.Password = priorAuth.Password
+	}
+
+	return auth
+}
+
+// pingdomAuth returns the credentials in the form "username:password" expected
+// by Pingdom. An empty string removes previously configured credentials, nil
+// keeps them, which is the case if the write-only password wasn't read.
+func (auth *CheckAuthModel) pingdomAuth() *string {
+	credentials := ""
+	switch {
+	case auth == nil:
+	case !auth.Password.IsNull():
+		credentials = fmt.Sprintf("%s:%s", auth.Username.ValueString(), auth.Password.ValueString())
+	case !auth.PasswordWO.IsNull():
+		credentials = fmt.Sprintf("%s:%s", auth.Username.ValueString(), auth.PasswordWO.ValueString())
+	default:
+		return nil
+	}
+
+	return &credentials
+}
+
+// readWriteOnlyPassword copies the write-only password from the configuration
+// into the auth model, as it is always null in the plan. The password is only
+// sent to Pingdom if password_wo_version or the username changed compared to
+// the prior state, so priorAuth is nil on create.
+func readWriteOnlyPassword(ctx context.Context, config tfsdk.Config, auth *CheckAuthModel, priorAuth *CheckAuthModel) diag.Diagnostics {
+	if auth == nil || !auth.Password.IsNull() {
+		return nil
+	}
+
+	if priorAuth != nil && priorAuth.Password.IsNull() &&
+		priorAuth.Username.Equal(auth.Username) && priorAuth.PasswordWOVersion.Equal(auth.PasswordWOVersion) {
+		return nil
+	}
+
+	return config.GetAttribute(ctx, path.Root("auth").AtName("password_wo"), &auth.PasswordWO)
+}
+
+// createCheckRequest builds the request with the shared attributes. The
+// parameters specific to the check type need to be added by the caller.
+func (model CheckModel) createCheckRequest(checkType string) api.CreateCheckRequest {
+	frequency, err := time.ParseDuration(model.Frequency.ValueString())
+	if err != nil {
+		panic(err)
+	}
+
+	userIds := []string{}
+	for _, contactId := range model.ContactIds.Elements() {
+		stringValue, ok := contactId.(types.String)
+		if !ok {
+			continue
+		}
+
+		userIds = append(userIds, stringValue.ValueString())
+	}
+
+	probeFilters := []string{}
+	for _, region := range model.Regions.Elements() {
+		stringValue, ok := region.(types.String)
+		if !ok {
+			continue
+		}
+
+		probeFilters = append(probeFilters, fmt.Sprintf("region: %s", stringValue.ValueString()))
+	}
+
+	tags := []string{}
+	for key, value := range model.Tags.Elements() {
+		stringValue, ok := value.(types.String)
+		if !ok {
+			continue
+		}
+
+		tags = append(tags, fmt.Sprintf("%s:%s", key, stringValue.ValueString()))
+	}
+
+	return api.CreateCheckRequest{
+		Name:                     model.Name.ValueString(),
+		Host:                     model.Host.ValueString(),
+		Type:                     checkType,
+		IPv6:                     model.IPv6.ValueBool(),
+		NotifyWhenBackup:         model.NotifyWhenBackUp.ValueBool(),
+		NotifyAgainEvery:         model.NotifyAgainEvery.ValueInt64(),
+		SendNotificationWhenDown: model.NotifyWhenDown.ValueInt64(),
+		ResponseTimeThreshold:    model.ResponseTimeThreshold.ValueInt64(),
+		CustomMessage:            model.Message.ValueString(),
+		Paused:                   model.Paused.ValueBool(),
+		UserIds:                  strings.Join(userIds, ","),
+		ProbeFilters:             probeFilters,
+		Tags:                     tags,
+		Resolution:               frequency.Minutes(),
+	}
+}
+
+func (r *checkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// createCheck creates the check and returns it as stored by Pingdom.
+func (r *checkResource) createCheck(ctx context.Context, body api.CreateCheckRequest, diagnostics *diag.Diagnostics) *api_types.Check {
+	checkId, err := r.client.CreateCheck(ctx, body)
+	if err != nil {
+		addClientError(diagnostics, "create check", err)
+		return nil
+	}
+
+	check, err := r.client.GetCheck(ctx, strconv.FormatInt(*checkId, 10))
+	if err != nil {
+		addClientError(diagnostics, "read created check", err)
+		return nil
+	}
+
+	return check
+}
+
+// readCheck returns the check with the given ID. If the check no longer exists
+// it is removed from the state and nil is returned.
+func (r *checkResource) readCheck(ctx context.Context, id string, checkType string, resp *resource.ReadResponse) *api_types.Check {
+	check, err := r.client.GetCheck(ctx, id)
+	if api.IsNotFound(err) {
+		tflog.Warn(ctx, "Check not found, removing it from state", map[string]interface{}{
+			"check.id": id,
+		})
+		resp.State.RemoveResource(ctx)
+		return nil
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read check", err)
+		return nil
+	}
+
+	// Guard against importing a check of another type.
+	if check.Type.Name() != checkType {
+		resp.Diagnostics.AddError(
+			"Unexpected Check Type",
+			fmt.Sprintf("The check %s is of type %q, use the %s resource instead of %s.", id, check.Type.Name(), checkResourceName(check.Type.Name()), checkResourceName(checkType)),
+		)
+		return nil
+	}
+
+	return check
+}
+
+// updateCheck updates the check and returns it as stored by Pingdom.
+func (r *checkResource) updateCheck(ctx context.Context, id string, body api.CreateCheckRequest, diagnostics *diag.Diagnostics) *api_types.Check {
+	err := r.client.UpdateCheck(ctx, id, body)
+	if err != nil {
+		addClientError(diagnostics, "update check", err)
+		return nil
+	}
+
+	check, err := r.client.GetCheck(ctx, id)
+	if err != nil {
+		addClientError(diagnostics, "read check", err)
+		return nil
+	}
+
+	return check
+}
+
+// checkResourceName returns the name of the resource managing checks of the
+// given type.
+func checkResourceName(checkType string) string {
+	if checkType == "httpcustom" {
+		return "pingdom_http_custom_check"
+	}
+
+	return fmt.Sprintf("pingdom_%s_check", checkType)
+}
+
+func (r *checkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCheck(ctx, id.ValueString())
+	if api.IsNotFound(err) {
+		// The check is already gone, which is what we wanted.
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "delete check", err)
+		return
+	}
+}
+
+func (r *checkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -77,7 +77,7 @@ func (d *ContactDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	res, err := d.client.GetContacts(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read contacts, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read contacts", err)
 		return
 	}
 
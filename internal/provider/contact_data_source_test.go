@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccContactDataSource(t *testing.T) {
+	server := newTestServer(t)
+	server.AddContact("Someone else")
+	id := server.AddContact("On-call")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+data "pingdom_contact" "test" {
+  name = "On-call"
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.pingdom_contact.test", "id", strconv.FormatInt(id, 10)),
+			},
+			{
+				Config: testProviderConfig(server) + `
+data "pingdom_contact" "test" {
+  name = "Nobody"
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to find contact with name"),
+			},
+		},
+	})
+}
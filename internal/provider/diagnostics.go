@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+)
+
+// addClientError appends an error diagnostic for a failed API call. Errors
+// returned by Pingdom are reported with the message of the server, so that
+// e.g. invalid parameters can be fixed without having to enable debug logs.
+func addClientError(diagnostics *diag.Diagnostics, action string, err error) {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to %s, got error: %s", action, err))
+		return
+	}
+
+	summary := "Pingdom API Error"
+	if apiErr.Description != "" {
+		summary = fmt.Sprintf("Pingdom API Error: %s", apiErr.Description)
+	}
+
+	detail := fmt.Sprintf("Unable to %s, Pingdom responded with status code %d to %s %s.", action, apiErr.StatusCode, apiErr.Method, apiErr.Path)
+	if apiErr.Message != "" {
+		detail += "\n\n" + apiErr.Message
+	}
+
+	diagnostics.AddError(summary, detail)
+}
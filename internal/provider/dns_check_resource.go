@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DNSCheckResource{}
+var _ resource.ResourceWithImportState = &DNSCheckResource{}
+var _ resource.ResourceWithValidateConfig = &DNSCheckResource{}
+
+func NewDNSCheckResource() resource.Resource {
+	return &DNSCheckResource{}
+}
+
+type DNSCheckResource struct {
+	checkResource
+}
+
+type DNSCheckResourceModel struct {
+	CheckModel
+
+	NameServer types.String `tfsdk:"nameserver"`
+	ExpectedIP types.String `tfsdk:"expected_ip"`
+}
+
+func (r *DNSCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_check"
+}
+
+func (r *DNSCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks whether the name server resolves the `host` to the expected IP address.",
+
+		Attributes: checkSchemaAttributes(map[string]schema.Attribute{
+			"nameserver": schema.StringAttribute{
+				MarkdownDescription: "The name server which is queried for the `host`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"expected_ip": schema.StringAttribute{
+				MarkdownDescription: "Trigger a downtime if the `host` does not resolve to this IPv4 or IPv6 address.",
+				Required:            true,
+				Validators: []validator.String{
+					ipAddress(),
+				},
+			},
+		}),
+	}
+}
+
+func (r *DNSCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateCheckConfig(ctx, req.Config, &resp.Diagnostics)
+}
+
+func transformPingdomCheckToDNSModel(check api_types.Check) (DNSCheckResourceModel, diag.Diagnostics) {
+	checkModel, diagnostics := transformPingdomCheckToCheckModel(check)
+	if diagnostics.HasError() {
+		return DNSCheckResourceModel{}, diagnostics
+	}
+
+	return DNSCheckResourceModel{
+		CheckModel: checkModel,
+
+		NameServer: types.StringValue(check.Type.DNS.NameServer),
+		ExpectedIP: types.StringValue(check.Type.DNS.ExpectedIP),
+	}, nil
+}
+
+func createDNSCheckRequestModel(resourceModel DNSCheckResourceModel) api.CreateCheckRequest {
+	body := resourceModel.createCheckRequest("dns")
+	body.DNS = &api.DNSCheckRequest{
+		NameServer: resourceModel.NameServer.ValueString(),
+		ExpectedIP: resourceModel.ExpectedIP.ValueString(),
+	}
+
+	return body
+}
+
+func (r *DNSCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model DNSCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.createCheck(ctx, createDNSCheckRequestModel(model), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToDNSModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *DNSCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model DNSCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.readCheck(ctx, model.Id.ValueString(), "dns", resp)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToDNSModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *DNSCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DNSCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.updateCheck(ctx, data.Id.ValueString(), createDNSCheckRequestModel(data), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToDNSModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDNSCheckResource(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_dns_check" "test" {
+  name        = "Example"
+  host        = "www.example.com"
+  nameserver  = "ns1.example.com"
+  expected_ip = "192.0.2.10"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pingdom_dns_check.test", "id"),
+					resource.TestCheckResourceAttr("pingdom_dns_check.test", "host", "www.example.com"),
+					resource.TestCheckResourceAttr("pingdom_dns_check.test", "nameserver", "ns1.example.com"),
+					resource.TestCheckResourceAttr("pingdom_dns_check.test", "expected_ip", "192.0.2.10"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pingdom_dns_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_dns_check" "test" {
+  name        = "Example"
+  host        = "www.example.com"
+  nameserver  = "ns2.example.com"
+  expected_ip = "2001:db8::10"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_dns_check.test", "nameserver", "ns2.example.com"),
+					resource.TestCheckResourceAttr("pingdom_dns_check.test", "expected_ip", "2001:db8::10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDNSCheckResource_invalidExpectedIP(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_dns_check" "test" {
+  name        = "Example"
+  host        = "www.example.com"
+  nameserver  = "ns1.example.com"
+  expected_ip = "192.0.2.300"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`not a valid IPv4 or IPv6 address`),
+			},
+		},
+	})
+}
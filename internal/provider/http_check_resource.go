@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -14,63 +16,47 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scayle/terraform-provider-pingdom/internal/api"
 	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
-	"strconv"
-	"strings"
-	"time"
+	"regexp"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &HTTPCheckResource{}
 var _ resource.ResourceWithImportState = &HTTPCheckResource{}
+var _ resource.ResourceWithConfigValidators = &HTTPCheckResource{}
+var _ resource.ResourceWithValidateConfig = &HTTPCheckResource{}
 
 func NewHTTPCheckResource() resource.Resource {
 	return &HTTPCheckResource{}
 }
 
 type HTTPCheckResource struct {
-	client api.Client
+	checkResource
 }
 
 type HTTPCheckResourceModel struct {
-	Id     types.String `tfsdk:"id"`
-	Name   types.String `tfsdk:"name"`
-	Paused types.Bool   `tfsdk:"paused"`
-
-	Host types.String        `tfsdk:"host"`
-	Url  types.String        `tfsdk:"url"`
-	Auth *HTTPCheckAuthModel `tfsdk:"auth"`
-
-	Frequency  types.String `tfsdk:"frequency"`
-	Message    types.String `tfsdk:"message"`
-	ContactIds types.Set    `tfsdk:"contact_ids"`
-	// Triggers a down alert if the response time exceeds threshold specified in ms.
-	ResponseTimeThreshold types.Int64 `tfsdk:"response_time_threshold"`
-	// Send notification when down X times
-	NotifyWhenDown types.Int64 `tfsdk:"notify_when_down"`
-	// Notify again every n result. 0 means that no extra notifications will be sent.
-	NotifyAgainEvery types.Int64 `tfsdk:"notify_again_every"`
-	// Notify when back up again
-	NotifyWhenBackUp types.Bool `tfsdk:"notify_when_back_up"`
+	CheckModel
 
-	// SSL configs
-	SSLDownDaysBefore types.Int64 `tfsdk:"ssl_down_days_before"`
-	VerifyCertificate types.Bool  `tfsdk:"verify_certificate"`
+	Url        types.String    `tfsdk:"url"`
+	Encryption types.Bool      `tfsdk:"encryption"`
+	Port       types.Int64     `tfsdk:"port"`
+	Auth       *CheckAuthModel `tfsdk:"auth"`
 
-	Regions types.Set `tfsdk:"regions"`
+	RequestHeaders types.Map `tfsdk:"request_headers"`
 
-	Tags types.Map `tfsdk:"tags"`
-}
+	// Response body assertions
+	ShouldContain    types.String `tfsdk:"should_contain"`
+	ShouldNotContain types.String `tfsdk:"should_not_contain"`
 
-type HTTPCheckAuthModel struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	PostData types.String `tfsdk:"post_data"`
+
+	// SSL configs
+	SSLDownDaysBefore types.Int64 `tfsdk:"ssl_down_days_before"`
+	VerifyCertificate types.Bool  `tfsdk:"verify_certificate"`
 }
 
 func (r *HTTPCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -81,293 +67,218 @@ func (r *HTTPCheckResource) Schema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "CheckDetail resource",
 
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Computed:            true,
-				MarkdownDescription: "The ID of the check in Pingdom.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the check.",
-				Required:            true,
-			},
-			"paused": schema.BoolAttribute{
-				MarkdownDescription: "Whether the check is paused.",
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(false),
-			},
-
-			"host": schema.StringAttribute{
-				MarkdownDescription: "The host of the check.",
-				Required:            true,
-			},
+		Attributes: checkSchemaAttributes(map[string]schema.Attribute{
 			"url": schema.StringAttribute{
 				MarkdownDescription: "A specific URL to check against.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("/"),
 			},
-			"auth": schema.SingleNestedAttribute{
-				MarkdownDescription: "Authentication configuration in case the host is protected by basic auth.",
-				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"username": schema.StringAttribute{
-						MarkdownDescription: "The username for basic auth.",
-						Required:            true,
-					},
-					"password": schema.StringAttribute{
-						MarkdownDescription: "The password for basic auth.",
-						Required:            true,
-						Sensitive:           true,
-					},
-				},
-			},
-
-			"frequency": schema.StringAttribute{
-				MarkdownDescription: "Define how frequent the check should run. Allowed values are: 1m, 5m, 15m, 30m and 60m.",
+			"encryption": schema.BoolAttribute{
+				MarkdownDescription: "Whether the check uses HTTPS. The default value is true.",
 				Optional:            true,
 				Computed:            true,
-				Validators: []validator.String{
-					stringvalidator.OneOf("1m", "5m", "15m", "30m", "60m"),
-				},
-				Default: stringdefault.StaticString("5m"),
-			},
-			"message": schema.StringAttribute{
-				MarkdownDescription: "A custom message for the check to be send in the notifications.",
-				Optional:            true,
+				Default:             booldefault.StaticBool(true),
 			},
-			"contact_ids": schema.SetAttribute{
-				MarkdownDescription: "A list of contact IDs that will be notified.",
-				ElementType:         types.StringType,
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port of the check. Defaults to 443 if `encryption` is enabled and 80 otherwise.",
 				Optional:            true,
 				Computed:            true,
-				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					defaultPortFromEncryption(),
+				},
 			},
-			"response_time_threshold": schema.Int64Attribute{
-				MarkdownDescription: "Triggers a downtime if the response time exceeds this threshold (in ms). The default value is 30s (30000ms).",
-				Optional:            true,
-				Computed:            true,
-				Default:             int64default.StaticInt64(30000),
+			"auth": checkAuthSchemaAttribute("Authentication configuration in case the host is protected by basic auth."),
+			"request_headers": schema.MapAttribute{
+				MarkdownDescription: "Custom HTTP headers sent with the request, e.g. a `User-Agent`, `Host` override or an API key header. " +
+					"Unless a `User-Agent` is configured, Pingdom sends its default User-Agent.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9!#$%&'*+.^_|~-]+$`), "must be a valid HTTP header name"),
+					),
+				},
 			},
-			"notify_when_down": schema.Int64Attribute{
-				MarkdownDescription: "Notify the contacts when the check is down for X times. The default value is 2.",
+			"should_contain": schema.StringAttribute{
+				MarkdownDescription: "Trigger a downtime if the response body does not contain this string. Conflicts with `should_not_contain`.",
 				Optional:            true,
-				Computed:            true,
-				Default:             int64default.StaticInt64(2),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
-			"notify_again_every": schema.Int64Attribute{
-				MarkdownDescription: "Notify the contacts again when the check continues to be down after X times. The default value is 0.",
+			"should_not_contain": schema.StringAttribute{
+				MarkdownDescription: "Trigger a downtime if the response body contains this string. Conflicts with `should_contain`.",
 				Optional:            true,
-				Computed:            true,
-				Default:             int64default.StaticInt64(0),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
-			"notify_when_back_up": schema.BoolAttribute{
-				MarkdownDescription: "Notify the contacts when the check is back-up.",
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(true),
+			"post_data": schema.StringAttribute{
+				MarkdownDescription: "Send a POST request with this body instead of a GET request. The body is sent as is, " +
+					"e.g. `user=name&token=secret` for a form or a JSON document for a GraphQL query. " +
+					"Set the matching `Content-Type` in `request_headers`, e.g. `application/json`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 
 			"ssl_down_days_before": schema.Int64Attribute{
-				MarkdownDescription: "Trigger a downtime if the SSL certificate expires in the given days. The default value is 7 days.",
+				MarkdownDescription: "Trigger a downtime if the SSL certificate expires in the given days. Only used if `encryption` is enabled. The default value is 7 days.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(7),
 			},
 			"verify_certificate": schema.BoolAttribute{
-				MarkdownDescription: "Trigger a downtime if the SSL certificate is invalid or unverifiable. The default value is true.",
+				MarkdownDescription: "Trigger a downtime if the SSL certificate is invalid or unverifiable. Only used if `encryption` is enabled. The default value is true.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
-
-			"regions": schema.SetAttribute{
-				MarkdownDescription: "A list of regions from which the check will be performed.",
-				ElementType:         types.StringType,
-				Optional:            true,
-				Computed:            true,
-				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
-				Validators: []validator.Set{
-					setvalidator.ValueStringsAre(
-						stringvalidator.OneOf("EU", "NA", "APAC", "LATAM"),
-					),
-				},
-			},
-
-			"tags": schema.MapAttribute{
-				MarkdownDescription: "A list of tags for the check.",
-				ElementType:         types.StringType,
-				Optional:            true,
-				Computed:            true,
-				Default:             mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
-			},
-		},
+		}),
 	}
 }
 
-func (r *HTTPCheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
-		return
+func (r *HTTPCheckResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("should_contain"),
+			path.MatchRoot("should_not_contain"),
+		),
 	}
+}
 
-	client, ok := req.ProviderData.(api.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+func (r *HTTPCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateCheckConfig(ctx, req.Config, &resp.Diagnostics)
 
+	var encryption, verifyCertificate types.Bool
+	var sslDownDaysBefore types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("encryption"), &encryption)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("verify_certificate"), &verifyCertificate)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ssl_down_days_before"), &sslDownDaysBefore)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.client = client
+	// The SSL settings have no effect on plain HTTP checks.
+	if !encryption.IsNull() && !encryption.IsUnknown() && !encryption.ValueBool() {
+		for attribute, value := range map[string]attr.Value{
+			"verify_certificate":   verifyCertificate,
+			"ssl_down_days_before": sslDownDaysBefore,
+		} {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("The attribute %s can only be set if encryption is enabled.", attribute),
+				)
+			}
+		}
+	}
 }
 
-func transformPingdomCheckToModel(check api_types.Check) (HTTPCheckResourceModel, diag.Diagnostics) {
-	var contactIds []attr.Value
-	for _, userId := range check.UserIDs {
-		contactIds = append(contactIds, types.StringValue(strconv.FormatInt(userId, 10)))
+// transformPingdomCheckToModel converts the check returned by Pingdom into the
+// resource model. The prior model from the plan or state is used to keep the
+// password settings which can not be read from Pingdom, and to tell a
+// configured User-Agent from the one Pingdom adds.
+func transformPingdomCheckToModel(check api_types.Check, prior HTTPCheckResourceModel) (HTTPCheckResourceModel, diag.Diagnostics) {
+	checkModel, diagnostics := transformPingdomCheckToCheckModel(check)
+	if diagnostics.HasError() {
+		return HTTPCheckResourceModel{}, diagnostics
 	}
 
-	tags := map[string]attr.Value{}
-	for _, tag := range check.Tags {
-		s := strings.Split(tag.Name, ":")
-		if len(s) != 2 {
-			continue
-		}
+	options := check.Type.HTTP
 
-		tags[s[0]] = types.StringValue(s[1])
-	}
+	auth := transformPingdomAuthToModel(options.Username, options.Password, prior.Auth)
 
-	var auth *HTTPCheckAuthModel
-	if check.Type.HTTP.Username != "" && check.Type.HTTP.Password != "" {
-		auth = &HTTPCheckAuthModel{
-			Username: types.StringValue(check.Type.HTTP.Username),
-			Password: types.StringValue(check.Type.HTTP.Password),
-		}
-	}
-
-	var regions []attr.Value
-	for _, filter := range check.ProbeFilters {
-		if !strings.HasPrefix(filter, "region: ") {
+	requestHeaders := map[string]attr.Value{}
+	for name, value := range options.RequestHeaders {
+		// Pingdom adds its own User-Agent to every check, which is not part of
+		// the configuration unless it was set explicitly.
+		if _, configured := prior.RequestHeaders.Elements()[name]; name == "User-Agent" && value == api_types.DefaultUserAgent && !configured {
 			continue
 		}
 
-		regions = append(regions, types.StringValue(strings.ReplaceAll(filter, "region: ", "")))
+		requestHeaders[name] = types.StringValue(value)
 	}
 
-	tfContactIds, diagnostics := types.SetValue(types.StringType, contactIds)
+	tfRequestHeaders, diagnostics := types.MapValue(types.StringType, requestHeaders)
 	if diagnostics.HasError() {
 		return HTTPCheckResourceModel{}, diagnostics
 	}
 
-	tfTags, diagnostics := types.MapValue(types.StringType, tags)
-	if diagnostics.HasError() {
-		return HTTPCheckResourceModel{}, diagnostics
+	postData := types.StringNull()
+	if options.PostData != "" {
+		postData = types.StringValue(options.PostData)
 	}
 
-	tfRegions, diagnostics := types.SetValue(types.StringType, regions)
-	if diagnostics.HasError() {
-		return HTTPCheckResourceModel{}, diagnostics
+	shouldContain := types.StringNull()
+	if options.ShouldContain != "" {
+		shouldContain = types.StringValue(options.ShouldContain)
 	}
 
-	message := types.StringNull()
-	if check.CustomMessage != "" {
-		message = types.StringValue(check.CustomMessage)
+	shouldNotContain := types.StringNull()
+	if options.ShouldNotContain != "" {
+		shouldNotContain = types.StringValue(options.ShouldNotContain)
 	}
 
 	return HTTPCheckResourceModel{
-		Id:     types.StringValue(strconv.FormatInt(check.Id, 10)),
-		Name:   types.StringValue(check.Name),
-		Paused: types.BoolValue(check.Status == "paused"),
+		CheckModel: checkModel,
 
-		Host: types.StringValue(check.Hostname),
-		Url:  types.StringValue(check.Type.HTTP.URL),
-		Auth: auth,
+		Url:        types.StringValue(options.URL),
+		Encryption: types.BoolValue(options.Encryption),
+		Port:       types.Int64Value(options.Port),
+		Auth:       auth,
 
-		Frequency:             types.StringValue(fmt.Sprintf("%dm", check.Resolution)),
-		Message:               message,
-		ContactIds:            tfContactIds,
-		ResponseTimeThreshold: types.Int64Value(check.ResponseTimeThreshold),
-		NotifyWhenDown:        types.Int64Value(check.SendNotificationWhenDown),
-		NotifyAgainEvery:      types.Int64Value(check.NotifyAgainEvery),
-		NotifyWhenBackUp:      types.BoolValue(check.NotifyWhenBackup),
+		RequestHeaders: tfRequestHeaders,
 
-		SSLDownDaysBefore: types.Int64Value(check.Type.HTTP.SSLDownDaysBefore),
-		VerifyCertificate: types.BoolValue(check.Type.HTTP.VerifyCertificate),
+		ShouldContain:    shouldContain,
+		ShouldNotContain: shouldNotContain,
 
-		Regions: tfRegions,
+		PostData: postData,
 
-		Tags: tfTags,
+		SSLDownDaysBefore: types.Int64Value(options.SSLDownDaysBefore),
+		VerifyCertificate: types.BoolValue(options.VerifyCertificate),
 	}, nil
 }
 
 func createCheckRequestModel(resourceModel HTTPCheckResourceModel) api.CreateCheckRequest {
-	frequency, err := time.ParseDuration(resourceModel.Frequency.ValueString())
-	if err != nil {
-		panic(err)
-	}
-
-	var auth string
-	if resourceModel.Auth != nil && !resourceModel.Auth.Username.IsNull() && resourceModel.Auth.Password.IsNull() {
-		auth = fmt.Sprintf("%s:%s", resourceModel.Auth.Username.ValueString(), resourceModel.Auth.Password.ValueString())
-	}
-
-	userIds := []string{}
-	for _, contactId := range resourceModel.ContactIds.Elements() {
-		stringValue, ok := contactId.(types.String)
-		if !ok {
-			continue
-		}
-
-		userIds = append(userIds, stringValue.ValueString())
-	}
-
-	probeFilters := []string{}
-	for _, region := range resourceModel.Regions.Elements() {
-		stringValue, ok := region.(types.String)
-		if !ok {
-			continue
-		}
-
-		probeFilters = append(probeFilters, fmt.Sprintf("region: %s", stringValue.ValueString()))
-	}
-
-	tags := []string{}
-	for key, value := range resourceModel.Tags.Elements() {
+	// Pingdom only replaces the request headers if at least one is sent, so the
+	// default User-Agent is sent to remove previously configured headers.
+	requestHeaders := map[string]string{}
+	for name, value := range resourceModel.RequestHeaders.Elements() {
 		stringValue, ok := value.(types.String)
 		if !ok {
 			continue
 		}
 
-		tags = append(tags, fmt.Sprintf("%s:%s", key, stringValue.ValueString()))
+		requestHeaders[name] = stringValue.ValueString()
+	}
+	if len(requestHeaders) == 0 {
+		requestHeaders["User-Agent"] = api_types.DefaultUserAgent
 	}
 
-	return api.CreateCheckRequest{
-		Name:                     resourceModel.Name.ValueString(),
-		Host:                     resourceModel.Host.ValueString(),
-		Auth:                     auth,
-		Encryption:               true,
-		Type:                     "http",
-		VerifyCertificate:        resourceModel.VerifyCertificate.ValueBool(),
-		SSLDownDaysBefore:        resourceModel.SSLDownDaysBefore.ValueInt64(),
-		NotifyWhenBackup:         resourceModel.NotifyWhenBackUp.ValueBool(),
-		NotifyAgainEvery:         resourceModel.NotifyAgainEvery.ValueInt64(),
-		SendNotificationWhenDown: resourceModel.NotifyWhenDown.ValueInt64(),
-		Url:                      resourceModel.Url.ValueString(),
-		ResponseTimeThreshold:    resourceModel.ResponseTimeThreshold.ValueInt64(),
-		CustomMessage:            resourceModel.Message.ValueString(),
-		Paused:                   resourceModel.Paused.ValueBool(),
-		UserIds:                  strings.Join(userIds, ","),
-		ProbeFilters:             probeFilters,
-		Tags:                     tags,
-		Resolution:               frequency.Minutes(),
+	body := resourceModel.createCheckRequest("http")
+	body.HTTP = &api.HTTPCheckRequest{
+		Url:               resourceModel.Url.ValueString(),
+		Encryption:        resourceModel.Encryption.ValueBool(),
+		Port:              resourceModel.Port.ValueInt64(),
+		Auth:              resourceModel.Auth.pingdomAuth(),
+		VerifyCertificate: resourceModel.VerifyCertificate.ValueBool(),
+		SSLDownDaysBefore: resourceModel.SSLDownDaysBefore.ValueInt64(),
+		RequestHeaders:    requestHeaders,
+		ShouldContain:     resourceModel.ShouldContain.ValueString(),
+		ShouldNotContain:  resourceModel.ShouldNotContain.ValueString(),
+		PostData:          resourceModel.PostData.ValueString(),
 	}
 
+	return body
 }
 
 func (r *HTTPCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -377,19 +288,17 @@ func (r *HTTPCheckResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	checkId, err := r.client.CreateCheck(ctx, createCheckRequestModel(model))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create check, got error: %s", err))
+	resp.Diagnostics.Append(readWriteOnlyPassword(ctx, req.Config, model.Auth, nil)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	check, err := r.client.GetCheck(ctx, strconv.FormatInt(*checkId, 10))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create check, got error: %s", err))
+	check := r.createCheck(ctx, createCheckRequestModel(model), &resp.Diagnostics)
+	if check == nil {
 		return
 	}
 
-	model, diagnostics := transformPingdomCheckToModel(*check)
+	model, diagnostics := transformPingdomCheckToModel(*check, model)
 	if diagnostics.HasError() {
 		resp.Diagnostics.Append(diagnostics...)
 		return
@@ -405,13 +314,12 @@ func (r *HTTPCheckResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	check, err := r.client.GetCheck(ctx, model.Id.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
+	check := r.readCheck(ctx, model.Id.ValueString(), "http", resp)
+	if check == nil {
 		return
 	}
 
-	model, diagnostics := transformPingdomCheckToModel(*check)
+	model, diagnostics := transformPingdomCheckToModel(*check, model)
 	if diagnostics.HasError() {
 		resp.Diagnostics.Append(diagnostics...)
 		return
@@ -427,19 +335,19 @@ func (r *HTTPCheckResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	err := r.client.UpdateCheck(ctx, data.Id.ValueString(), createCheckRequestModel(data))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
+	var priorAuth *CheckAuthModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("auth"), &priorAuth)...)
+	resp.Diagnostics.Append(readWriteOnlyPassword(ctx, req.Config, data.Auth, priorAuth)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	check, err := r.client.GetCheck(ctx, data.Id.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
+	check := r.updateCheck(ctx, data.Id.ValueString(), createCheckRequestModel(data), &resp.Diagnostics)
+	if check == nil {
 		return
 	}
 
-	model, diagnostics := transformPingdomCheckToModel(*check)
+	model, diagnostics := transformPingdomCheckToModel(*check, data)
 	if diagnostics.HasError() {
 		resp.Diagnostics.Append(diagnostics...)
 		return
@@ -447,21 +355,3 @@ func (r *HTTPCheckResource) Update(ctx context.Context, req resource.UpdateReque
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
-
-func (r *HTTPCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data HTTPCheckResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	err := r.client.DeleteCheck(ctx, data.Id.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete example, got error: %s", err))
-		return
-	}
-}
-
-func (r *HTTPCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-}
@@ -0,0 +1,549 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/scayle/terraform-provider-pingdom/internal/api/fake"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+func TestAccHTTPCheckResource(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name      = "Example"
+  host      = "example.com"
+  frequency = "1m"
+  regions   = ["EU"]
+  tags      = { team = "platform" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pingdom_http_check.test", "id"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "name", "Example"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "host", "example.com"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "url", "/"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "encryption", "true"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "port", "443"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "frequency", "1m"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "paused", "false"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "regions.#", "1"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "regions.0", "EU"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "tags.team", "platform"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pingdom_http_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name      = "Example updated"
+  host      = "example.com"
+  url       = "/health"
+  frequency = "5m"
+  paused    = true
+  regions   = ["EU", "NA"]
+  tags      = { team = "platform", service = "payments" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "name", "Example updated"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "url", "/health"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "frequency", "5m"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "paused", "true"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "regions.#", "2"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "tags.service", "payments"),
+				),
+			},
+			// Removing all regions probes from all of them again.
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name      = "Example updated"
+  host      = "example.com"
+  url       = "/health"
+  frequency = "5m"
+  paused    = true
+  tags      = { team = "platform", service = "payments" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "regions.#", "0"),
+					func(s *terraform.State) error {
+						id, err := testCheckID(s, "pingdom_http_check.test")
+						if err != nil {
+							return err
+						}
+
+						check, ok := server.Check(id)
+						if !ok {
+							return fmt.Errorf("check %d not found", id)
+						}
+						if len(check.ProbeFilters) != 0 {
+							return fmt.Errorf("expected no probe filters, got %v", check.ProbeFilters)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccHTTPCheckResource_requestHeaders(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name            = "Example"
+  host            = "example.com"
+  request_headers = { "X-Api-Key" = "key", "Host" = "internal.example.com" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "request_headers.%", "2"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "request_headers.X-Api-Key", "key"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "request_headers.Host", "internal.example.com"),
+				),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name            = "Example"
+  host            = "example.com"
+  request_headers = { "User-Agent" = "health-check" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "request_headers.%", "1"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "request_headers.User-Agent", "health-check"),
+				),
+			},
+			// Configuring Pingdom's own User-Agent must not cause a diff.
+			{
+				Config: testProviderConfig(server) + fmt.Sprintf(`
+resource "pingdom_http_check" "test" {
+  name            = "Example"
+  host            = "example.com"
+  request_headers = { "User-Agent" = %q }
+}
+`, api_types.DefaultUserAgent),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "request_headers.%", "1"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "request_headers.User-Agent", api_types.DefaultUserAgent),
+				),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name = "Example"
+  host = "example.com"
+}
+`,
+				Check: resource.TestCheckResourceAttr("pingdom_http_check.test", "request_headers.%", "0"),
+			},
+		},
+	})
+}
+
+func TestAccHTTPCheckResource_bodyAssertions(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name               = "Example"
+  host               = "example.com"
+  should_contain     = "ok"
+  should_not_contain = "degraded"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name           = "Example"
+  host           = "example.com"
+  should_contain = "\"status\":\"ok\""
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "should_contain", `"status":"ok"`),
+					resource.TestCheckNoResourceAttr("pingdom_http_check.test", "should_not_contain"),
+				),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name               = "Example"
+  host               = "example.com"
+  should_not_contain = "degraded"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("pingdom_http_check.test", "should_contain"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "should_not_contain", "degraded"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHTTPCheckResource_postData(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name            = "Example"
+  host            = "example.com"
+  url             = "/graphql"
+  post_data       = jsonencode({ query = "{ health }" })
+  request_headers = { "Content-Type" = "application/json" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "post_data", `{"query":"{ health }"}`),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "request_headers.Content-Type", "application/json"),
+				),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name = "Example"
+  host = "example.com"
+  url  = "/graphql"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("pingdom_http_check.test", "post_data"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "request_headers.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHTTPCheckResource_encryption(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name               = "Example"
+  host               = "example.com"
+  encryption         = false
+  verify_certificate = false
+}
+`,
+				ExpectError: regexp.MustCompile("can only be set if encryption is enabled"),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name       = "Example"
+  host       = "example.com"
+  encryption = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "encryption", "false"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "port", "80"),
+				),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name       = "Example"
+  host       = "example.com"
+  encryption = false
+  port       = 8080
+}
+`,
+				Check: resource.TestCheckResourceAttr("pingdom_http_check.test", "port", "8080"),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name = "Example"
+  host = "example.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "encryption", "true"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "port", "443"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHTTPCheckResource_ipv6(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name    = "Example"
+  host    = "example.com"
+  ipv6    = true
+  regions = ["EU", "APAC"]
+}
+`,
+				ExpectError: regexp.MustCompile("The region APAC does not offer IPv6 probes"),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name    = "Example"
+  host    = "example.com"
+  ipv6    = true
+  regions = ["EU", "NA"]
+}
+`,
+				Check: resource.TestCheckResourceAttr("pingdom_http_check.test", "ipv6", "true"),
+			},
+		},
+	})
+}
+
+func TestAccHTTPCheckResource_auth(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name = "Example"
+  host = "example.com"
+  auth = { username = "admin", password = "secret" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "auth.username", "admin"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "auth.password", "secret"),
+					testCheckHTTPAuth(server, "pingdom_http_check.test", "admin", "secret"),
+					// The username changed outside of Terraform needs to be reverted.
+					testCheckModifyCheck(server, "pingdom_http_check.test", func(check *api_types.Check) { check.Type.HTTP.Username = "root" }),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name = "Example"
+  host = "example.com"
+  auth = { username = "admin", password = "changed" }
+}
+`,
+				Check: testCheckHTTPAuth(server, "pingdom_http_check.test", "admin", "changed"),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name = "Example"
+  host = "example.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("pingdom_http_check.test", "auth"),
+					testCheckHTTPAuth(server, "pingdom_http_check.test", "", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHTTPCheckResource_authWriteOnly(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name = "Example"
+  host = "example.com"
+  auth = { username = "admin", password_wo = "secret", password_wo_version = 1 }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "auth.username", "admin"),
+					resource.TestCheckNoResourceAttr("pingdom_http_check.test", "auth.password"),
+					resource.TestCheckNoResourceAttr("pingdom_http_check.test", "auth.password_wo"),
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "auth.password_wo_version", "1"),
+					testCheckHTTPAuth(server, "pingdom_http_check.test", "admin", "secret"),
+				),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name = "Example"
+  host = "example.com"
+  auth = { username = "admin", password_wo = "changed", password_wo_version = 2 }
+}
+`,
+				Check: testCheckHTTPAuth(server, "pingdom_http_check.test", "admin", "changed"),
+			},
+			// The password is only sent if its version changes.
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name = "Example renamed"
+  host = "example.com"
+  auth = { username = "admin", password_wo = "ignored", password_wo_version = 2 }
+}
+`,
+				Check: testCheckHTTPAuth(server, "pingdom_http_check.test", "admin", "changed"),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name = "Example renamed"
+  host = "example.com"
+  auth = { username = "operator", password_wo = "rotated", password_wo_version = 2 }
+}
+`,
+				Check: testCheckHTTPAuth(server, "pingdom_http_check.test", "operator", "rotated"),
+			},
+		},
+	})
+}
+
+// testCheckHTTPAuth verifies the basic auth credentials stored in the fake
+// server.
+func testCheckHTTPAuth(server *fake.Server, resourceName, username, password string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testCheckID(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		check, ok := server.Check(id)
+		if !ok {
+			return fmt.Errorf("check %d not found", id)
+		}
+		if check.Type.HTTP.Username != username || check.Type.HTTP.Password != password {
+			return fmt.Errorf("expected credentials %q:%q, got %q:%q", username, password, check.Type.HTTP.Username, check.Type.HTTP.Password)
+		}
+
+		return nil
+	}
+}
+
+func TestAccHTTPCheckResource_drift(t *testing.T) {
+	server := newTestServer(t)
+
+	config := testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name = "Example"
+  host = "example.com"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testCheckModifyCheck(server, "pingdom_http_check.test", func(check *api_types.Check) { check.Name = "Changed in UI" }),
+				// The name changed outside of Terraform needs to be reverted.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_http_check.test", "name", "Example"),
+					testCheckDeleteCheck(server, "pingdom_http_check.test"),
+				),
+				// The check deleted outside of Terraform needs to be recreated.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttrSet("pingdom_http_check.test", "id"),
+			},
+		},
+	})
+}
+
+// testCheckModifyCheck changes the check in the fake server, as if it was
+// modified in the Pingdom UI.
+func testCheckModifyCheck(server *fake.Server, resourceName string, update func(check *api_types.Check)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testCheckID(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if !server.UpdateCheck(id, update) {
+			return fmt.Errorf("check %d not found", id)
+		}
+		return nil
+	}
+}
+
+// testCheckDeleteCheck removes the check from the fake server, as if it was
+// deleted in the Pingdom UI.
+func testCheckDeleteCheck(server *fake.Server, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testCheckID(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		server.DeleteCheck(id)
+		return nil
+	}
+}
+
+// testCheckID returns the Pingdom ID of the check managed by the resource.
+func testCheckID(s *terraform.State, resourceName string) (int64, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return 0, fmt.Errorf("resource %s not found in state", resourceName)
+	}
+
+	return strconv.ParseInt(rs.Primary.ID, 10, 64)
+}
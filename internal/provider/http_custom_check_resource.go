@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+	"regexp"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HTTPCustomCheckResource{}
+var _ resource.ResourceWithImportState = &HTTPCustomCheckResource{}
+var _ resource.ResourceWithValidateConfig = &HTTPCustomCheckResource{}
+
+func NewHTTPCustomCheckResource() resource.Resource {
+	return &HTTPCustomCheckResource{}
+}
+
+type HTTPCustomCheckResource struct {
+	checkResource
+}
+
+type HTTPCustomCheckResourceModel struct {
+	CheckModel
+
+	Url            types.String `tfsdk:"url"`
+	Encryption     types.Bool   `tfsdk:"encryption"`
+	Port           types.Int64  `tfsdk:"port"`
+	AdditionalUrls types.List   `tfsdk:"additional_urls"`
+}
+
+func (r *HTTPCustomCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_http_custom_check"
+}
+
+func (r *HTTPCustomCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Requests a URL which answers in the custom XML status format of Pingdom, e.g. " +
+			"`<pingdom_http_custom_check><status>OK</status><response_time>96.777</response_time></pingdom_http_custom_check>`, " +
+			"and triggers a downtime unless the status is `OK`.",
+
+		Attributes: checkSchemaAttributes(map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The path of the XML status document on the `host`, e.g. `/status.xml`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must start with /"),
+				},
+			},
+			"encryption": schema.BoolAttribute{
+				MarkdownDescription: "Whether the check uses HTTPS. The default value is true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port of the check. Defaults to 443 if `encryption` is enabled and 80 otherwise.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					defaultPortFromEncryption(),
+				},
+			},
+			"additional_urls": schema.ListAttribute{
+				MarkdownDescription: "Additional XML status documents including their host, e.g. `www.example.com/status.xml`, which are requested as part of the check.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[^;]+$`), "must not be empty or contain a semicolon"),
+					),
+				},
+			},
+		}),
+	}
+}
+
+func (r *HTTPCustomCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateCheckConfig(ctx, req.Config, &resp.Diagnostics)
+}
+
+func transformPingdomCheckToHTTPCustomModel(check api_types.Check) (HTTPCustomCheckResourceModel, diag.Diagnostics) {
+	checkModel, diagnostics := transformPingdomCheckToCheckModel(check)
+	if diagnostics.HasError() {
+		return HTTPCustomCheckResourceModel{}, diagnostics
+	}
+
+	options := check.Type.HTTPCustom
+
+	additionalUrls := []attr.Value{}
+	for _, url := range options.AdditionalURLs {
+		additionalUrls = append(additionalUrls, types.StringValue(url))
+	}
+
+	tfAdditionalUrls, diagnostics := types.ListValue(types.StringType, additionalUrls)
+	if diagnostics.HasError() {
+		return HTTPCustomCheckResourceModel{}, diagnostics
+	}
+
+	return HTTPCustomCheckResourceModel{
+		CheckModel: checkModel,
+
+		Url:            types.StringValue(options.URL),
+		Encryption:     types.BoolValue(options.Encryption),
+		Port:           types.Int64Value(options.Port),
+		AdditionalUrls: tfAdditionalUrls,
+	}, nil
+}
+
+func createHTTPCustomCheckRequestModel(resourceModel HTTPCustomCheckResourceModel) api.CreateCheckRequest {
+	additionalUrls := []string{}
+	for _, url := range resourceModel.AdditionalUrls.Elements() {
+		stringValue, ok := url.(types.String)
+		if !ok {
+			continue
+		}
+
+		additionalUrls = append(additionalUrls, stringValue.ValueString())
+	}
+
+	body := resourceModel.createCheckRequest("httpcustom")
+	body.HTTPCustom = &api.HTTPCustomCheckRequest{
+		Url:            resourceModel.Url.ValueString(),
+		Encryption:     resourceModel.Encryption.ValueBool(),
+		Port:           resourceModel.Port.ValueInt64(),
+		AdditionalURLs: strings.Join(additionalUrls, ";"),
+	}
+
+	return body
+}
+
+func (r *HTTPCustomCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model HTTPCustomCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.createCheck(ctx, createHTTPCustomCheckRequestModel(model), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToHTTPCustomModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *HTTPCustomCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model HTTPCustomCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.readCheck(ctx, model.Id.ValueString(), "httpcustom", resp)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToHTTPCustomModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *HTTPCustomCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data HTTPCustomCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.updateCheck(ctx, data.Id.ValueString(), createHTTPCustomCheckRequestModel(data), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToHTTPCustomModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccHTTPCustomCheckResource(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_custom_check" "test" {
+  name            = "Example"
+  host            = "legacy.example.com"
+  url             = "/status.xml"
+  additional_urls = ["legacy.example.com/db.xml", "legacy.example.com/queue.xml"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pingdom_http_custom_check.test", "id"),
+					resource.TestCheckResourceAttr("pingdom_http_custom_check.test", "url", "/status.xml"),
+					resource.TestCheckResourceAttr("pingdom_http_custom_check.test", "encryption", "true"),
+					resource.TestCheckResourceAttr("pingdom_http_custom_check.test", "port", "443"),
+					resource.TestCheckResourceAttr("pingdom_http_custom_check.test", "additional_urls.#", "2"),
+					resource.TestCheckResourceAttr("pingdom_http_custom_check.test", "additional_urls.1", "legacy.example.com/queue.xml"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pingdom_http_custom_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_custom_check" "test" {
+  name       = "Example"
+  host       = "legacy.example.com"
+  url        = "/status.xml"
+  encryption = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_http_custom_check.test", "encryption", "false"),
+					resource.TestCheckResourceAttr("pingdom_http_custom_check.test", "port", "80"),
+					resource.TestCheckResourceAttr("pingdom_http_custom_check.test", "additional_urls.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHTTPCustomCheckResource_importOtherType(t *testing.T) {
+	server := newTestServer(t)
+
+	config := testProviderConfig(server) + `
+resource "pingdom_http_custom_check" "test" {
+  name = "Example"
+  host = "example.com"
+  url  = "/status.xml"
+}
+
+resource "pingdom_http_check" "test" {
+  name = "Example"
+  host = "example.com"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config:       config,
+				ResourceName: "pingdom_http_check.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["pingdom_http_custom_check.test"].Primary.ID, nil
+				},
+				ExpectError: regexp.MustCompile(`use the pingdom_http_custom_check`),
+			},
+		},
+	})
+}
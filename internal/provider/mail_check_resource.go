@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MailCheckResource{}
+var _ resource.ResourceWithImportState = &MailCheckResource{}
+var _ resource.ResourceWithValidateConfig = &MailCheckResource{}
+
+func NewPOP3CheckResource() resource.Resource {
+	return &MailCheckResource{checkType: "pop3", defaultPort: 110}
+}
+
+func NewIMAPCheckResource() resource.Resource {
+	return &MailCheckResource{checkType: "imap", defaultPort: 143}
+}
+
+// MailCheckResource implements the POP3 and IMAP check resources, which only
+// differ in their check type and default port.
+type MailCheckResource struct {
+	checkResource
+
+	checkType   string
+	defaultPort int64
+}
+
+type MailCheckResourceModel struct {
+	CheckModel
+
+	Port           types.Int64  `tfsdk:"port"`
+	StringToExpect types.String `tfsdk:"string_to_expect"`
+}
+
+func (r *MailCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.checkType + "_check"
+}
+
+func (r *MailCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	protocol := strings.ToUpper(r.checkType)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Checks whether a %s server accepts connections and optionally answers with the expected string.", protocol),
+
+		Attributes: checkSchemaAttributes(map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The port of the %s server. The default value is %d.", protocol, r.defaultPort),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(r.defaultPort),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"string_to_expect": schema.StringAttribute{
+				MarkdownDescription: "Trigger a downtime if the response of the server does not contain this string.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		}),
+	}
+}
+
+func (r *MailCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateCheckConfig(ctx, req.Config, &resp.Diagnostics)
+}
+
+func transformPingdomCheckToMailModel(check api_types.Check) (MailCheckResourceModel, diag.Diagnostics) {
+	checkModel, diagnostics := transformPingdomCheckToCheckModel(check)
+	if diagnostics.HasError() {
+		return MailCheckResourceModel{}, diagnostics
+	}
+
+	var port int64
+	var stringToExpect string
+	switch {
+	case check.Type.POP3 != nil:
+		port, stringToExpect = check.Type.POP3.Port, check.Type.POP3.StringToExpect
+	case check.Type.IMAP != nil:
+		port, stringToExpect = check.Type.IMAP.Port, check.Type.IMAP.StringToExpect
+	}
+
+	model := MailCheckResourceModel{
+		CheckModel: checkModel,
+
+		Port:           types.Int64Value(port),
+		StringToExpect: types.StringNull(),
+	}
+	if stringToExpect != "" {
+		model.StringToExpect = types.StringValue(stringToExpect)
+	}
+
+	return model, nil
+}
+
+func (r *MailCheckResource) createCheckRequestModel(resourceModel MailCheckResourceModel) api.CreateCheckRequest {
+	body := resourceModel.createCheckRequest(r.checkType)
+
+	port := resourceModel.Port.ValueInt64()
+	stringToExpect := resourceModel.StringToExpect.ValueString()
+	switch r.checkType {
+	case "pop3":
+		body.POP3 = &api.POP3CheckRequest{Port: port, StringToExpect: stringToExpect}
+	case "imap":
+		body.IMAP = &api.IMAPCheckRequest{Port: port, StringToExpect: stringToExpect}
+	}
+
+	return body
+}
+
+func (r *MailCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model MailCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.createCheck(ctx, r.createCheckRequestModel(model), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToMailModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *MailCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model MailCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.readCheck(ctx, model.Id.ValueString(), r.checkType, resp)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToMailModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *MailCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MailCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.updateCheck(ctx, data.Id.ValueString(), r.createCheckRequestModel(data), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToMailModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMailCheckResource(t *testing.T) {
+	for checkType, defaultPort := range map[string]string{"pop3": "110", "imap": "143"} {
+		t.Run(checkType, func(t *testing.T) {
+			server := newTestServer(t)
+			resourceName := fmt.Sprintf("pingdom_%s_check.test", checkType)
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					// Create and Read testing
+					{
+						Config: testProviderConfig(server) + fmt.Sprintf(`
+resource "pingdom_%s_check" "test" {
+  name             = "Example"
+  host             = "mail.example.com"
+  string_to_expect = "OK"
+}
+`, checkType),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttrSet(resourceName, "id"),
+							resource.TestCheckResourceAttr(resourceName, "port", defaultPort),
+							resource.TestCheckResourceAttr(resourceName, "string_to_expect", "OK"),
+						),
+					},
+					// ImportState testing
+					{
+						ResourceName:      resourceName,
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+					// Update and Read testing
+					{
+						Config: testProviderConfig(server) + fmt.Sprintf(`
+resource "pingdom_%s_check" "test" {
+  name = "Example"
+  host = "mail.example.com"
+  port = 1143
+}
+`, checkType),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(resourceName, "port", "1143"),
+							resource.TestCheckNoResourceAttr(resourceName, "string_to_expect"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
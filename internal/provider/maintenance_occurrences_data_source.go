@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	"regexp"
+	"strconv"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MaintenanceOccurrencesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &MaintenanceOccurrencesDataSource{}
+
+func NewMaintenanceOccurrencesDataSource() datasource.DataSource {
+	return &MaintenanceOccurrencesDataSource{}
+}
+
+type MaintenanceOccurrencesDataSource struct {
+	client api.Client
+}
+
+type MaintenanceOccurrencesDataSourceModel struct {
+	MaintenanceId types.String `tfsdk:"maintenance_id"`
+	From          types.String `tfsdk:"from"`
+	To            types.String `tfsdk:"to"`
+
+	Occurrences []MaintenanceOccurrenceModel `tfsdk:"occurrences"`
+}
+
+type MaintenanceOccurrenceModel struct {
+	Id            types.String `tfsdk:"id"`
+	MaintenanceId types.String `tfsdk:"maintenance_id"`
+	From          types.String `tfsdk:"from"`
+	To            types.String `tfsdk:"to"`
+}
+
+func (d *MaintenanceOccurrencesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_occurrences"
+}
+
+func (d *MaintenanceOccurrencesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the occurrences of maintenance windows, i.e. the single windows of recurring maintenance. " +
+			"Set `from` and `to` to the same time to find the maintenance windows which are ongoing at that time.",
+
+		Attributes: map[string]schema.Attribute{
+			"maintenance_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the occurrences of the maintenance window with this ID.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a numeric ID"),
+				},
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Only return occurrences which end at or after this time, in RFC 3339 format, e.g. `2025-01-01T00:00:00Z`.",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "Only return occurrences which start at or before this time, in RFC 3339 format, e.g. `2025-02-01T00:00:00Z`.",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"occurrences": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching occurrences, ordered by their start.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the occurrence.",
+							Computed:            true,
+						},
+						"maintenance_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the maintenance window.",
+							Computed:            true,
+						},
+						"from": schema.StringAttribute{
+							MarkdownDescription: "The start of the occurrence in RFC 3339 format.",
+							Computed:            true,
+						},
+						"to": schema.StringAttribute{
+							MarkdownDescription: "The end of the occurrence in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MaintenanceOccurrencesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data MaintenanceOccurrencesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Invalid timestamps are reported by the validators of the attributes.
+	from, fromOk := parseKnownTimestamp(data.From)
+	to, toOk := parseKnownTimestamp(data.To)
+	if fromOk && toOk && to.Before(from) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("to"),
+			"Invalid Range",
+			fmt.Sprintf("The start of the range %s must not be after its end %s.", data.From.ValueString(), data.To.ValueString()),
+		)
+	}
+}
+
+func (d *MaintenanceOccurrencesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MaintenanceOccurrencesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MaintenanceOccurrencesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The values are validated by the schema.
+	filter := api.GetMaintenanceOccurrencesRequest{}
+	if !data.MaintenanceId.IsNull() {
+		filter.MaintenanceId, _ = strconv.ParseInt(data.MaintenanceId.ValueString(), 10, 64)
+	}
+	filter.From, _ = parseKnownTimestamp(data.From)
+	filter.To, _ = parseKnownTimestamp(data.To)
+
+	occurrences, err := d.client.GetMaintenanceOccurrences(ctx, filter)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read maintenance occurrences", err)
+		return
+	}
+
+	data.Occurrences = []MaintenanceOccurrenceModel{}
+	for _, occurrence := range occurrences {
+		data.Occurrences = append(data.Occurrences, MaintenanceOccurrenceModel{
+			Id:            types.StringValue(strconv.FormatInt(occurrence.Id, 10)),
+			MaintenanceId: types.StringValue(strconv.FormatInt(occurrence.MaintenanceId, 10)),
+			From:          types.StringValue(formatUnix(occurrence.From)),
+			To:            types.StringValue(formatUnix(occurrence.To)),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMaintenanceOccurrencesDataSource(t *testing.T) {
+	server := newTestServer(t)
+	now := time.Now()
+	config := testProviderConfig(server) + testMaintenanceWindowsConfig(now)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+data "pingdom_maintenance_occurrences" "test" {
+  from = "2025-02-01T00:00:00Z"
+  to   = "2025-01-01T00:00:00Z"
+}
+`,
+				ExpectError: regexp.MustCompile(`must not be after\s+its\s+end`),
+			},
+			// The maintenance windows need to exist when the data sources are read.
+			{
+				Config: config,
+			},
+			{
+				Config: config + `
+data "pingdom_maintenance_occurrences" "backup" {
+  maintenance_id = pingdom_maintenance.backup.id
+}
+
+data "pingdom_maintenance_occurrences" "now" {
+  from = "` + now.UTC().Format(time.RFC3339) + `"
+  to   = "` + now.UTC().Format(time.RFC3339) + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The daily window occurs until the end of the recurrence.
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_occurrences.backup", "occurrences.#", "4"),
+					resource.TestCheckResourceAttrSet("data.pingdom_maintenance_occurrences.backup", "occurrences.0.id"),
+					resource.TestCheckResourceAttrPair("data.pingdom_maintenance_occurrences.backup", "occurrences.0.maintenance_id", "pingdom_maintenance.backup", "id"),
+					resource.TestCheckResourceAttrPair("data.pingdom_maintenance_occurrences.backup", "occurrences.0.from", "pingdom_maintenance.backup", "from"),
+					resource.TestCheckResourceAttrPair("data.pingdom_maintenance_occurrences.backup", "occurrences.0.to", "pingdom_maintenance.backup", "to"),
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_occurrences.backup", "occurrences.1.from", now.Add(-2*time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)),
+
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_occurrences.now", "occurrences.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pingdom_maintenance_occurrences.now", "occurrences.0.maintenance_id", "pingdom_maintenance.ongoing", "id"),
+				),
+			},
+		},
+	})
+}
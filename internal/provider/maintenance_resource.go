@@ -0,0 +1,549 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MaintenanceResource{}
+var _ resource.ResourceWithImportState = &MaintenanceResource{}
+var _ resource.ResourceWithValidateConfig = &MaintenanceResource{}
+var _ resource.ResourceWithModifyPlan = &MaintenanceResource{}
+
+func NewMaintenanceResource() resource.Resource {
+	return &MaintenanceResource{}
+}
+
+type MaintenanceResource struct {
+	client api.Client
+}
+
+type MaintenanceResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+
+	From           types.String `tfsdk:"from"`
+	To             types.String `tfsdk:"to"`
+	RecurrenceType types.String `tfsdk:"recurrence_type"`
+	RepeatEvery    types.Int64  `tfsdk:"repeat_every"`
+	EffectiveTo    types.String `tfsdk:"effective_to"`
+
+	UptimeCheckIds      types.Set `tfsdk:"uptime_check_ids"`
+	TransactionCheckIds types.Set `tfsdk:"transaction_check_ids"`
+	// CheckTags selects the uptime checks instead of UptimeCheckIds
+	CheckTags types.Set `tfsdk:"check_tags"`
+}
+
+func (r *MaintenanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance"
+}
+
+func (r *MaintenanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Maintenance window in which the alerts of the selected checks are suppressed, optionally repeated every few days, weeks or months.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the maintenance window in Pingdom.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the maintenance window.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"from": schema.StringAttribute{
+				MarkdownDescription: "The start of the (first) maintenance window in RFC 3339 format, e.g. `2025-01-31T22:00:00Z`. Pingdom stores it with a precision of seconds.",
+				Required:            true,
+				Validators: []validator.String{
+					maintenanceTimestamp(),
+				},
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "The end of the (first) maintenance window in RFC 3339 format, e.g. `2025-02-01T02:00:00Z`. Pingdom stores it with a precision of seconds.",
+				Required:            true,
+				Validators: []validator.String{
+					maintenanceTimestamp(),
+				},
+			},
+			"recurrence_type": schema.StringAttribute{
+				MarkdownDescription: "How the maintenance window is repeated. Allowed values are: none, day, week and month. The default value is none.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "day", "week", "month"),
+				},
+			},
+			"repeat_every": schema.Int64Attribute{
+				MarkdownDescription: "The number of days, weeks or months between the repetitions of the maintenance window, which must be at least 1 unless `recurrence_type` is none. The default value is 0.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"effective_to": schema.StringAttribute{
+				MarkdownDescription: "The end of the recurrence in RFC 3339 format. Defaults to `to`, i.e. the maintenance window is not repeated.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					maintenanceTimestamp(),
+				},
+			},
+
+			"uptime_check_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the uptime checks, e.g. HTTP or TCP checks, which are in maintenance. Computed from `check_tags` if it is set.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a numeric ID"),
+					),
+				},
+			},
+			"transaction_check_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the transaction checks which are in maintenance.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a numeric ID"),
+					),
+				},
+			},
+			"check_tags": schema.SetAttribute{
+				MarkdownDescription: "Select the uptime checks by their tags in the format `key:value`, e.g. `service:payments` for checks with `tags = { service = \"payments\" }`. " +
+					"All checks with at least one of the tags are in maintenance. The checks are looked up whenever a plan is created, so that checks tagged since the last apply cause a diff. " +
+					"On create and if the selected checks change, `uptime_check_ids` is known after apply and the checks are looked up again while applying, the plan lists the currently selected checks in a warning. " +
+					"Checks created in the same apply are only selected if they are created first, e.g. by adding them to `depends_on`. Conflicts with `uptime_check_ids`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[^:]+:`), "must be a tag in the format key:value"),
+					),
+					setvalidator.ConflictsWith(path.MatchRoot("uptime_check_ids")),
+				},
+			},
+		},
+	}
+}
+
+func (r *MaintenanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model MaintenanceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Invalid timestamps are reported by the validators of the attributes.
+	from, fromOk := parseKnownTimestamp(model.From)
+	to, toOk := parseKnownTimestamp(model.To)
+	if fromOk && toOk && !from.Before(to) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("to"),
+			"Invalid Maintenance Window",
+			fmt.Sprintf("The start of the maintenance window %s must be before its end %s.", model.From.ValueString(), model.To.ValueString()),
+		)
+	}
+
+	// repeat_every defaults to 0, which Pingdom only accepts for windows which
+	// are not repeated.
+	recurrenceType := model.RecurrenceType.ValueString()
+	if recurrenceType != "" && recurrenceType != "none" && !model.RepeatEvery.IsUnknown() && model.RepeatEvery.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("repeat_every"),
+			"Invalid Maintenance Window",
+			fmt.Sprintf("A maintenance window repeated every %s must set repeat_every to at least 1.", recurrenceType),
+		)
+	}
+
+	effectiveTo, effectiveToOk := parseKnownTimestamp(model.EffectiveTo)
+	if toOk && effectiveToOk && effectiveTo.Before(to) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("effective_to"),
+			"Invalid Maintenance Window",
+			fmt.Sprintf("The end of the recurrence %s must not be before the end of the maintenance window %s.", model.EffectiveTo.ValueString(), model.To.ValueString()),
+		)
+	}
+}
+
+func (r *MaintenanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resolve if the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The end of the recurrence defaults to the end of the first window, so it
+	// is known before the apply.
+	var effectiveTo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("effective_to"), &effectiveTo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if effectiveTo.IsNull() {
+		var to types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("to"), &to)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_to"), to)...)
+	}
+
+	var checkTags types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("check_tags"), &checkTags)...)
+	if resp.Diagnostics.HasError() || checkTags.IsNull() {
+		return
+	}
+
+	// The checks can't be looked up yet, e.g. because the tags depend on
+	// other resources or the provider configuration is unknown.
+	if checkTags.IsUnknown() || r.client == nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("uptime_check_ids"), types.SetUnknown(types.StringType))...)
+		return
+	}
+
+	uptimeCheckIds, diagnostics := r.lookupCheckTags(ctx, checkTags)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Terraform plans the resource again while applying, where a different
+	// result of the lookup, e.g. because a check with the tags is created in
+	// the same apply, fails the apply. The IDs are therefore only kept in the
+	// plan if neither they nor any other attribute change, otherwise they are
+	// looked up again by Create or Update.
+	if !req.State.Raw.IsNull() {
+		var priorUptimeCheckIds types.Set
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("uptime_check_ids"), &priorUptimeCheckIds)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("uptime_check_ids"), priorUptimeCheckIds)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if uptimeCheckIds.Equal(priorUptimeCheckIds) && resp.Plan.Raw.Equal(req.State.Raw) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("uptime_check_ids"), types.SetUnknown(types.StringType))...)
+
+	// Show the selected checks in the plan, as uptime_check_ids is unknown.
+	var ids []string
+	resp.Diagnostics.Append(uptimeCheckIds.ElementsAs(ctx, &ids, false)...)
+	if len(ids) > 0 {
+		sort.Strings(ids)
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("check_tags"),
+			"Checks Selected by Tags",
+			fmt.Sprintf("The tags currently select the uptime checks %s. uptime_check_ids is known after apply, as the checks are looked up again while applying.", strings.Join(ids, ", ")),
+		)
+	}
+}
+
+// lookupCheckTags returns the IDs of the uptime checks with at least one of
+// the given tags.
+func (r *MaintenanceResource) lookupCheckTags(ctx context.Context, checkTags types.Set) (types.Set, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	var tags []string
+	diagnostics.Append(checkTags.ElementsAs(ctx, &tags, false)...)
+	if diagnostics.HasError() {
+		return types.SetNull(types.StringType), diagnostics
+	}
+
+	checks, err := r.client.GetChecks(ctx, api.GetChecksRequest{Tags: tags})
+	if err != nil {
+		addClientError(&diagnostics, "list checks", err)
+		return types.SetNull(types.StringType), diagnostics
+	}
+
+	ids := []int64{}
+	for _, check := range checks {
+		ids = append(ids, check.Id)
+	}
+	if len(ids) == 0 {
+		diagnostics.AddAttributeWarning(
+			path.Root("check_tags"),
+			"No Checks Found",
+			fmt.Sprintf("There are no uptime checks with the tags %s, the maintenance window does not cover any uptime check.", strings.Join(tags, ", ")),
+		)
+	}
+
+	tflog.Debug(ctx, "Resolved check tags of maintenance window", map[string]interface{}{
+		"check_tags":       tags,
+		"uptime_check_ids": ids,
+	})
+
+	uptimeCheckIds, setDiagnostics := types.SetValueFrom(ctx, types.StringType, formatIDs(ids))
+	diagnostics.Append(setDiagnostics...)
+
+	return uptimeCheckIds, diagnostics
+}
+
+func (r *MaintenanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// parseKnownTimestamp parses an RFC 3339 timestamp. It reports false if the
+// value is null, unknown or invalid.
+func parseKnownTimestamp(value types.String) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return timestamp, true
+}
+
+// formatTimestamp converts seconds since the epoch into an RFC 3339 timestamp.
+// The prior value from the plan or state is kept if it denotes the same point
+// in time, so that e.g. timestamps with a time zone offset don't cause a diff.
+func formatTimestamp(seconds int64, prior types.String) types.String {
+	timestamp := time.Unix(seconds, 0)
+	if parsed, ok := parseKnownTimestamp(prior); ok && parsed.Equal(timestamp) {
+		return prior
+	}
+
+	return types.StringValue(formatUnix(seconds))
+}
+
+// transformPingdomMaintenanceToModel converts the maintenance window returned
+// by Pingdom into the resource model. The prior model from the plan or state
+// is used to keep the format of the timestamps and the check tags, which are
+// not known to Pingdom.
+func transformPingdomMaintenanceToModel(maintenance api_types.Maintenance, prior MaintenanceResourceModel) (MaintenanceResourceModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	uptimeCheckIds, uptimeDiagnostics := types.SetValueFrom(context.Background(), types.StringType, formatIDs(maintenance.Checks.Uptime))
+	diagnostics.Append(uptimeDiagnostics...)
+
+	transactionCheckIds, tmsDiagnostics := types.SetValueFrom(context.Background(), types.StringType, formatIDs(maintenance.Checks.TMS))
+	diagnostics.Append(tmsDiagnostics...)
+
+	if diagnostics.HasError() {
+		return MaintenanceResourceModel{}, diagnostics
+	}
+
+	return MaintenanceResourceModel{
+		Id:          types.StringValue(strconv.FormatInt(maintenance.Id, 10)),
+		Description: types.StringValue(maintenance.Description),
+
+		From:           formatTimestamp(maintenance.From, prior.From),
+		To:             formatTimestamp(maintenance.To, prior.To),
+		RecurrenceType: types.StringValue(maintenance.RecurrenceType),
+		RepeatEvery:    types.Int64Value(maintenance.RepeatEvery),
+		EffectiveTo:    formatTimestamp(maintenance.EffectiveTo, prior.EffectiveTo),
+
+		UptimeCheckIds:      uptimeCheckIds,
+		TransactionCheckIds: transactionCheckIds,
+		CheckTags:           prior.CheckTags,
+	}, nil
+}
+
+func createMaintenanceRequestModel(resourceModel MaintenanceResourceModel) api.CreateMaintenanceRequest {
+	// The timestamps are validated by the schema.
+	from, _ := parseKnownTimestamp(resourceModel.From)
+	to, _ := parseKnownTimestamp(resourceModel.To)
+
+	effectiveTo, ok := parseKnownTimestamp(resourceModel.EffectiveTo)
+	if !ok {
+		effectiveTo = to
+	}
+
+	return api.CreateMaintenanceRequest{
+		Description:    resourceModel.Description.ValueString(),
+		From:           from.Unix(),
+		To:             to.Unix(),
+		RecurrenceType: resourceModel.RecurrenceType.ValueString(),
+		RepeatEvery:    resourceModel.RepeatEvery.ValueInt64(),
+		EffectiveTo:    effectiveTo.Unix(),
+		UptimeIds:      joinIDs(parseIDs(resourceModel.UptimeCheckIds)),
+		TmsIds:         joinIDs(parseIDs(resourceModel.TransactionCheckIds)),
+	}
+}
+
+// joinIDs converts numeric Pingdom IDs into a comma separated list.
+func joinIDs(ids []int64) string {
+	return strings.Join(formatIDs(ids), ",")
+}
+
+func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MaintenanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.UptimeCheckIds.IsUnknown() {
+		uptimeCheckIds, diagnostics := r.lookupCheckTags(ctx, data.CheckTags)
+		resp.Diagnostics.Append(diagnostics...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.UptimeCheckIds = uptimeCheckIds
+	}
+
+	id, err := r.client.CreateMaintenance(ctx, createMaintenanceRequestModel(data))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create maintenance window", err)
+		return
+	}
+
+	// Pingdom only returns the ID of the new maintenance window.
+	maintenance, err := r.client.GetMaintenance(ctx, strconv.FormatInt(*id, 10))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read maintenance window", err)
+		return
+	}
+
+	model, diagnostics := transformPingdomMaintenanceToModel(*maintenance, data)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *MaintenanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MaintenanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenance, err := r.client.GetMaintenance(ctx, data.Id.ValueString())
+	if api.IsNotFound(err) {
+		tflog.Warn(ctx, "Maintenance window not found, removing it from state", map[string]interface{}{
+			"maintenance.id": data.Id.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read maintenance window", err)
+		return
+	}
+
+	model, diagnostics := transformPingdomMaintenanceToModel(*maintenance, data)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MaintenanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.UptimeCheckIds.IsUnknown() {
+		uptimeCheckIds, diagnostics := r.lookupCheckTags(ctx, data.CheckTags)
+		resp.Diagnostics.Append(diagnostics...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.UptimeCheckIds = uptimeCheckIds
+	}
+
+	err := r.client.UpdateMaintenance(ctx, data.Id.ValueString(), createMaintenanceRequestModel(data))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update maintenance window", err)
+		return
+	}
+
+	maintenance, err := r.client.GetMaintenance(ctx, data.Id.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read maintenance window", err)
+		return
+	}
+
+	model, diagnostics := transformPingdomMaintenanceToModel(*maintenance, data)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *MaintenanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMaintenance(ctx, id.ValueString())
+	if api.IsNotFound(err) {
+		// The maintenance window is already gone, which is what we wanted.
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "delete maintenance window", err)
+		return
+	}
+}
+
+func (r *MaintenanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
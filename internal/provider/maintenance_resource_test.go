@@ -0,0 +1,623 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	"github.com/scayle/terraform-provider-pingdom/internal/api/fake"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+const testMaintenanceChecksConfig = `
+resource "pingdom_http_check" "test" {
+  name      = "Example"
+  host      = "example.com"
+  frequency = "1m"
+  regions   = ["EU"]
+}
+
+resource "pingdom_transaction_check" "test" {
+  name = "Login"
+
+  steps = [
+    { fn = "go_to", args = { url = "https://example.com/login" } },
+  ]
+}
+`
+
+func TestAccMaintenanceResource(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testProviderConfig(server) + testMaintenanceChecksConfig + `
+resource "pingdom_maintenance" "test" {
+  description           = "Database upgrade"
+  from                  = "2025-02-01T01:00:00+01:00"
+  to                    = "2025-02-01T02:30:00Z"
+  uptime_check_ids      = [pingdom_http_check.test.id]
+  transaction_check_ids = [pingdom_transaction_check.test.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pingdom_maintenance.test", "id"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "description", "Database upgrade"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "from", "2025-02-01T01:00:00+01:00"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "to", "2025-02-01T02:30:00Z"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "recurrence_type", "none"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "repeat_every", "0"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "effective_to", "2025-02-01T02:30:00Z"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "uptime_check_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("pingdom_maintenance.test", "uptime_check_ids.0", "pingdom_http_check.test", "id"),
+					resource.TestCheckResourceAttrPair("pingdom_maintenance.test", "transaction_check_ids.0", "pingdom_transaction_check.test", "id"),
+					testCheckMaintenance(server, "pingdom_maintenance.test", func(maintenance api_types.Maintenance) error {
+						from := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC).Unix()
+						to := time.Date(2025, 2, 1, 2, 30, 0, 0, time.UTC).Unix()
+						if maintenance.From != from || maintenance.To != to || maintenance.EffectiveTo != to {
+							return fmt.Errorf("unexpected window %d-%d until %d", maintenance.From, maintenance.To, maintenance.EffectiveTo)
+						}
+						if len(maintenance.Checks.Uptime) != 1 || len(maintenance.Checks.TMS) != 1 {
+							return fmt.Errorf("unexpected checks %+v", maintenance.Checks)
+						}
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "pingdom_maintenance.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"from"},
+			},
+			// Update and Read testing
+			{
+				Config: testProviderConfig(server) + testMaintenanceChecksConfig + `
+resource "pingdom_maintenance" "test" {
+  description      = "Weekly backup"
+  from             = "2025-02-02T03:00:00Z"
+  to               = "2025-02-02T04:00:00Z"
+  recurrence_type  = "week"
+  repeat_every     = 1
+  effective_to     = "2025-12-31T00:00:00Z"
+  uptime_check_ids = [pingdom_http_check.test.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "description", "Weekly backup"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "from", "2025-02-02T03:00:00Z"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "recurrence_type", "week"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "repeat_every", "1"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "effective_to", "2025-12-31T00:00:00Z"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "uptime_check_ids.#", "1"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "transaction_check_ids.#", "0"),
+					testCheckMaintenance(server, "pingdom_maintenance.test", func(maintenance api_types.Maintenance) error {
+						if maintenance.EffectiveTo != time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC).Unix() {
+							return fmt.Errorf("unexpected effective to %d", maintenance.EffectiveTo)
+						}
+						if len(maintenance.Checks.TMS) != 0 {
+							return fmt.Errorf("unexpected transaction checks %v", maintenance.Checks.TMS)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMaintenanceResource_drift(t *testing.T) {
+	server := newTestServer(t)
+
+	config := testProviderConfig(server) + testMaintenanceChecksConfig + `
+resource "pingdom_maintenance" "test" {
+  description      = "Release"
+  from             = "2025-03-01T20:00:00Z"
+  to               = "2025-03-01T21:00:00Z"
+  uptime_check_ids = [pingdom_http_check.test.id]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// Changes made in the Pingdom UI are detected and reverted.
+			{
+				Config: config,
+				Check: testCheckModifyMaintenance(server, "pingdom_maintenance.test", func(maintenance *api_types.Maintenance) {
+					maintenance.To += 3600
+					maintenance.EffectiveTo = maintenance.To
+					maintenance.Checks.Uptime = []int64{}
+				}),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "to", "2025-03-01T21:00:00Z"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "uptime_check_ids.#", "1"),
+				),
+			},
+			// Maintenance windows deleted in the Pingdom UI are created again.
+			{
+				Config:             config,
+				Check:              testCheckDeleteMaintenance(server, "pingdom_maintenance.test"),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttrSet("pingdom_maintenance.test", "id"),
+			},
+		},
+	})
+}
+
+func TestAccMaintenanceResource_checkTags(t *testing.T) {
+	server := newTestServer(t)
+
+	checksConfig := testProviderConfig(server) + `
+resource "pingdom_http_check" "payments" {
+  name      = "Payments"
+  host      = "payments.example.com"
+  frequency = "1m"
+  regions   = ["EU"]
+  tags      = { service = "payments" }
+}
+
+resource "pingdom_http_check" "search" {
+  name      = "Search"
+  host      = "search.example.com"
+  frequency = "1m"
+  regions   = ["EU"]
+  tags      = { service = "search", team = "platform" }
+}
+`
+	config := checksConfig + `
+resource "pingdom_maintenance" "test" {
+  description = "Payment provider downtime"
+  from        = "2025-03-01T20:00:00Z"
+  to          = "2025-03-01T21:00:00Z"
+  check_tags  = ["service:payments"]
+}
+`
+
+	var otherCheckId int64
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: checksConfig + `
+resource "pingdom_maintenance" "test" {
+  description      = "Payment provider downtime"
+  from             = "2025-03-01T20:00:00Z"
+  to               = "2025-03-01T21:00:00Z"
+  check_tags       = ["service:payments"]
+  uptime_check_ids = ["1"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: checksConfig,
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("pingdom_maintenance.test", tfjsonpath.New("uptime_check_ids")),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "uptime_check_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("pingdom_maintenance.test", "uptime_check_ids.0", "pingdom_http_check.payments", "id"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "check_tags.#", "1"),
+				),
+			},
+			// Checks tagged outside of Terraform are picked up by the next plan.
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					otherCheckId = server.AddCheck(api_types.Check{
+						Name:     "Payments API",
+						Hostname: "api.payments.example.com",
+						Type:     api_types.CheckTypes{HTTP: &api_types.CheckHTTPOptions{}},
+						Tags:     []api_types.CheckTag{{Name: "service:payments", Type: "u", Count: "1"}},
+					})
+					return nil
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pingdom_maintenance.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("pingdom_maintenance.test", tfjsonpath.New("uptime_check_ids")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "uptime_check_ids.#", "2"),
+					testCheckMaintenance(server, "pingdom_maintenance.test", func(maintenance api_types.Maintenance) error {
+						if !slices.Contains(maintenance.Checks.Uptime, otherCheckId) {
+							return fmt.Errorf("expected check %d in %v", otherCheckId, maintenance.Checks.Uptime)
+						}
+						return nil
+					}),
+				),
+			},
+			// Selecting checks by several tags covers all of them.
+			{
+				Config: checksConfig + `
+resource "pingdom_maintenance" "test" {
+  description = "Payment provider downtime"
+  from        = "2025-03-01T20:00:00Z"
+  to          = "2025-03-01T21:00:00Z"
+  check_tags  = ["service:payments", "team:platform"]
+}
+`,
+				Check: resource.TestCheckResourceAttr("pingdom_maintenance.test", "uptime_check_ids.#", "3"),
+			},
+			// Switching back to explicit IDs replaces the checks selected by tags.
+			{
+				Config: checksConfig + `
+resource "pingdom_maintenance" "test" {
+  description      = "Payment provider downtime"
+  from             = "2025-03-01T20:00:00Z"
+  to               = "2025-03-01T21:00:00Z"
+  uptime_check_ids = [pingdom_http_check.search.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("pingdom_maintenance.test", "check_tags"),
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "uptime_check_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("pingdom_maintenance.test", "uptime_check_ids.0", "pingdom_http_check.search", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMaintenanceResource_checkTagsSameApply(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "payments" {
+  name      = "Payments"
+  host      = "payments.example.com"
+  frequency = "1m"
+  regions   = ["EU"]
+  tags      = { service = "payments" }
+}
+
+resource "pingdom_maintenance" "test" {
+  description = "Payment provider downtime"
+  from        = "2025-03-01T20:00:00Z"
+  to          = "2025-03-01T21:00:00Z"
+  check_tags  = ["service:payments"]
+
+  depends_on = [pingdom_http_check.payments]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_maintenance.test", "uptime_check_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("pingdom_maintenance.test", "uptime_check_ids.0", "pingdom_http_check.payments", "id"),
+				),
+			},
+			// A check added in the same apply as another change of the
+			// maintenance window must not change the plan during the apply.
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_http_check" "payments" {
+  name      = "Payments"
+  host      = "payments.example.com"
+  frequency = "1m"
+  regions   = ["EU"]
+  tags      = { service = "payments" }
+}
+
+resource "pingdom_http_check" "checkout" {
+  name      = "Checkout"
+  host      = "checkout.example.com"
+  frequency = "1m"
+  regions   = ["EU"]
+  tags      = { service = "payments" }
+}
+
+resource "pingdom_maintenance" "test" {
+  description = "Payment provider and checkout downtime"
+  from        = "2025-03-01T20:00:00Z"
+  to          = "2025-03-01T21:00:00Z"
+  check_tags  = ["service:payments"]
+
+  depends_on = [pingdom_http_check.payments, pingdom_http_check.checkout]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("pingdom_maintenance.test", tfjsonpath.New("uptime_check_ids")),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("pingdom_maintenance.test", "uptime_check_ids.#", "2"),
+			},
+		},
+	})
+}
+
+func TestAccMaintenanceResource_invalidWindow(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_maintenance" "test" {
+  description = "Release"
+  from        = "2025-03-01T21:00:00Z"
+  to          = "2025-03-01T20:00:00Z"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be before\s+its\s+end`),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_maintenance" "test" {
+  description  = "Release"
+  from         = "2025-03-01T20:00:00Z"
+  to           = "2025-03-01T21:00:00Z"
+  effective_to = "2025-03-01T20:30:00Z"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must not be before\s+the\s+end`),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_maintenance" "test" {
+  description = "Release"
+  from        = "tomorrow"
+  to          = "2025-03-01T21:00:00Z"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Timestamp`),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_maintenance" "test" {
+  description = "Release"
+  from        = "2025-03-01T20:00:00.5Z"
+  to          = "2025-03-01T21:00:00Z"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`fractional\s+seconds`),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_maintenance" "test" {
+  description     = "Release"
+  from            = "2025-03-01T20:00:00Z"
+  to              = "2025-03-01T21:00:00Z"
+  recurrence_type = "week"
+  effective_to    = "2025-06-01T21:00:00Z"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`repeat_every\s+to\s+at\s+least\s+1`),
+			},
+		},
+	})
+}
+
+func TestMaintenanceResource_ModifyPlanCheckTags(t *testing.T) {
+	server := newTestServer(t)
+	payments := server.AddCheck(api_types.Check{
+		Name:     "Payments",
+		Hostname: "payments.example.com",
+		Type:     api_types.CheckTypes{HTTP: &api_types.CheckHTTPOptions{}},
+		Tags:     []api_types.CheckTag{{Name: "service:payments", Type: "u", Count: "1"}},
+	})
+	paymentsAPI := server.AddCheck(api_types.Check{
+		Name:     "Payments API",
+		Hostname: "api.payments.example.com",
+		Type:     api_types.CheckTypes{HTTP: &api_types.CheckHTTPOptions{}},
+		Tags:     []api_types.CheckTag{{Name: "service:payments", Type: "u", Count: "1"}},
+	})
+	selected := fmt.Sprintf("%d, %d", payments, paymentsAPI)
+
+	tests := []struct {
+		name        string
+		checkTags   []string
+		priorIds    []string
+		wantIds     []string
+		wantWarning string
+		wantDetail  string
+	}{
+		{
+			name:        "create lists the selected checks",
+			checkTags:   []string{"service:payments"},
+			wantWarning: "Checks Selected by Tags",
+			wantDetail:  selected,
+		},
+		{
+			name:        "create warns about tags without checks",
+			checkTags:   []string{"service:search"},
+			wantWarning: "No Checks Found",
+			wantDetail:  "service:search",
+		},
+		{
+			name:      "unchanged checks are kept",
+			checkTags: []string{"service:payments"},
+			priorIds:  []string{strconv.FormatInt(payments, 10), strconv.FormatInt(paymentsAPI, 10)},
+			wantIds:   []string{strconv.FormatInt(payments, 10), strconv.FormatInt(paymentsAPI, 10)},
+		},
+		{
+			name:        "newly tagged checks are listed",
+			checkTags:   []string{"service:payments"},
+			priorIds:    []string{strconv.FormatInt(payments, 10)},
+			wantWarning: "Checks Selected by Tags",
+			wantDetail:  selected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &MaintenanceResource{client: api.New("test-token", api.WithBaseURL(server.APIURL()), api.WithMaxRetries(0))}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+			checkTags, _ := types.SetValueFrom(ctx, types.StringType, tt.checkTags)
+			model := MaintenanceResourceModel{
+				Id:                  types.StringNull(),
+				Description:         types.StringValue("Payment provider downtime"),
+				From:                types.StringValue("2025-03-01T20:00:00Z"),
+				To:                  types.StringValue("2025-03-01T21:00:00Z"),
+				RecurrenceType:      types.StringValue("none"),
+				RepeatEvery:         types.Int64Value(0),
+				EffectiveTo:         types.StringNull(),
+				UptimeCheckIds:      types.SetNull(types.StringType),
+				TransactionCheckIds: types.SetValueMust(types.StringType, []attr.Value{}),
+				CheckTags:           checkTags,
+			}
+
+			// The config has no setter, so it is built from a plan.
+			configPlan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diagnostics := configPlan.Set(ctx, &model); diagnostics.HasError() {
+				t.Fatal(diagnostics)
+			}
+			config := tfsdk.Config{Schema: schemaResp.Schema, Raw: configPlan.Raw}
+
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			model.Id = types.StringUnknown()
+			model.UptimeCheckIds = types.SetUnknown(types.StringType)
+			if tt.priorIds != nil {
+				model.Id = types.StringValue("1")
+				model.EffectiveTo = model.To
+				model.UptimeCheckIds, _ = types.SetValueFrom(ctx, types.StringType, tt.priorIds)
+				if diagnostics := state.Set(ctx, &model); diagnostics.HasError() {
+					t.Fatal(diagnostics)
+				}
+			}
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diagnostics := plan.Set(ctx, &model); diagnostics.HasError() {
+				t.Fatal(diagnostics)
+			}
+
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Config: config, Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var uptimeCheckIds types.Set
+			resp.Plan.GetAttribute(ctx, path.Root("uptime_check_ids"), &uptimeCheckIds)
+			if tt.wantIds == nil && !uptimeCheckIds.IsUnknown() {
+				t.Errorf("expected uptime_check_ids to be unknown, got %s", uptimeCheckIds)
+			}
+			if tt.wantIds != nil {
+				wantIds, _ := types.SetValueFrom(ctx, types.StringType, tt.wantIds)
+				if !uptimeCheckIds.Equal(wantIds) {
+					t.Errorf("expected uptime_check_ids %s, got %s", wantIds, uptimeCheckIds)
+				}
+			}
+
+			warnings := resp.Diagnostics.Warnings()
+			if tt.wantWarning == "" {
+				if len(warnings) != 0 {
+					t.Errorf("unexpected warnings: %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || warnings[0].Summary() != tt.wantWarning || !strings.Contains(warnings[0].Detail(), tt.wantDetail) {
+				t.Errorf("expected a single %q warning mentioning %s, got %v", tt.wantWarning, tt.wantDetail, warnings)
+			}
+		})
+	}
+}
+
+// testCheckMaintenance runs assertions on the maintenance window stored in the
+// fake server.
+func testCheckMaintenance(server *fake.Server, resourceName string, check func(maintenance api_types.Maintenance) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testCheckID(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		maintenance, ok := server.Maintenance(id)
+		if !ok {
+			return fmt.Errorf("maintenance window %d not found", id)
+		}
+
+		return check(maintenance)
+	}
+}
+
+// testCheckModifyMaintenance changes the maintenance window in the fake
+// server, as if it was changed in the Pingdom UI.
+func testCheckModifyMaintenance(server *fake.Server, resourceName string, update func(maintenance *api_types.Maintenance)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testCheckID(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if !server.UpdateMaintenance(id, update) {
+			return fmt.Errorf("maintenance window %d not found", id)
+		}
+
+		return nil
+	}
+}
+
+// testCheckDeleteMaintenance deletes the maintenance window in the fake
+// server, as if it was deleted in the Pingdom UI.
+func testCheckDeleteMaintenance(server *fake.Server, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testCheckID(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		server.DeleteMaintenance(id)
+
+		return nil
+	}
+}
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MaintenanceWindowsDataSource{}
+
+func NewMaintenanceWindowsDataSource() datasource.DataSource {
+	return &MaintenanceWindowsDataSource{}
+}
+
+type MaintenanceWindowsDataSource struct {
+	client api.Client
+}
+
+type MaintenanceWindowsDataSourceModel struct {
+	Description types.String `tfsdk:"description"`
+	State       types.String `tfsdk:"state"`
+
+	Ids     []types.String               `tfsdk:"ids"`
+	Windows []MaintenanceWindowItemModel `tfsdk:"windows"`
+}
+
+type MaintenanceWindowItemModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Description         types.String `tfsdk:"description"`
+	From                types.String `tfsdk:"from"`
+	To                  types.String `tfsdk:"to"`
+	RecurrenceType      types.String `tfsdk:"recurrence_type"`
+	RepeatEvery         types.Int64  `tfsdk:"repeat_every"`
+	EffectiveTo         types.String `tfsdk:"effective_to"`
+	UptimeCheckIds      types.Set    `tfsdk:"uptime_check_ids"`
+	TransactionCheckIds types.Set    `tfsdk:"transaction_check_ids"`
+	State               types.String `tfsdk:"state"`
+}
+
+func (d *MaintenanceWindowsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_windows"
+}
+
+func (d *MaintenanceWindowsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the maintenance windows, optionally filtered by description and by whether they are ongoing, upcoming or past. " +
+			"Use the `pingdom_maintenance_occurrences` data source to read the single occurrences of recurring windows.",
+
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Only return maintenance windows whose description contains this value.",
+				Optional:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Only return maintenance windows in this state. Allowed values are: ongoing, upcoming and past.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ongoing", "upcoming", "past"),
+				},
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching maintenance windows, ordered by ID.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"windows": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching maintenance windows, ordered by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the maintenance window.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the maintenance window.",
+							Computed:            true,
+						},
+						"from": schema.StringAttribute{
+							MarkdownDescription: "The start of the first maintenance window in RFC 3339 format.",
+							Computed:            true,
+						},
+						"to": schema.StringAttribute{
+							MarkdownDescription: "The end of the first maintenance window in RFC 3339 format.",
+							Computed:            true,
+						},
+						"recurrence_type": schema.StringAttribute{
+							MarkdownDescription: "How the maintenance window is repeated, one of none, day, week and month.",
+							Computed:            true,
+						},
+						"repeat_every": schema.Int64Attribute{
+							MarkdownDescription: "The number of days, weeks or months between the repetitions.",
+							Computed:            true,
+						},
+						"effective_to": schema.StringAttribute{
+							MarkdownDescription: "The end of the recurrence in RFC 3339 format.",
+							Computed:            true,
+						},
+						"uptime_check_ids": schema.SetAttribute{
+							MarkdownDescription: "The IDs of the uptime checks in maintenance.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"transaction_check_ids": schema.SetAttribute{
+							MarkdownDescription: "The IDs of the transaction checks in maintenance.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Whether an occurrence of the maintenance window is ongoing, upcoming or all of them are past. " +
+								"Recurring windows are upcoming between their occurrences.",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MaintenanceWindowsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MaintenanceWindowsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MaintenanceWindowsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windows, err := d.client.GetMaintenanceWindows(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read maintenance windows", err)
+		return
+	}
+
+	// Pingdom doesn't guarantee the order of the results.
+	slices.SortFunc(windows, func(a, b api_types.Maintenance) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	// The state of the windows is derived from their current and future
+	// occurrences, so that recurring windows are handled like Pingdom does.
+	now := time.Now()
+	occurrences, err := d.client.GetMaintenanceOccurrences(ctx, api.GetMaintenanceOccurrencesRequest{From: now})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read maintenance occurrences", err)
+		return
+	}
+
+	data.Ids = []types.String{}
+	data.Windows = []MaintenanceWindowItemModel{}
+	for _, window := range windows {
+		// Pingdom does not support filtering by description or state.
+		if !data.Description.IsNull() && !strings.Contains(window.Description, data.Description.ValueString()) {
+			continue
+		}
+
+		state := maintenanceState(window.Id, occurrences, now)
+		if !data.State.IsNull() && state != data.State.ValueString() {
+			continue
+		}
+
+		uptimeCheckIds, diagnostics := types.SetValueFrom(ctx, types.StringType, formatIDs(window.Checks.Uptime))
+		resp.Diagnostics.Append(diagnostics...)
+
+		transactionCheckIds, diagnostics := types.SetValueFrom(ctx, types.StringType, formatIDs(window.Checks.TMS))
+		resp.Diagnostics.Append(diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id := types.StringValue(strconv.FormatInt(window.Id, 10))
+		data.Ids = append(data.Ids, id)
+		data.Windows = append(data.Windows, MaintenanceWindowItemModel{
+			Id:                  id,
+			Description:         types.StringValue(window.Description),
+			From:                types.StringValue(formatUnix(window.From)),
+			To:                  types.StringValue(formatUnix(window.To)),
+			RecurrenceType:      types.StringValue(window.RecurrenceType),
+			RepeatEvery:         types.Int64Value(window.RepeatEvery),
+			EffectiveTo:         types.StringValue(formatUnix(window.EffectiveTo)),
+			UptimeCheckIds:      uptimeCheckIds,
+			TransactionCheckIds: transactionCheckIds,
+			State:               types.StringValue(state),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// maintenanceState returns whether the maintenance window is ongoing, upcoming
+// or past, given the occurrences which end after now.
+func maintenanceState(id int64, occurrences []api_types.MaintenanceOccurrence, now time.Time) string {
+	state := "past"
+	for _, occurrence := range occurrences {
+		if occurrence.MaintenanceId != id || occurrence.To < now.Unix() {
+			continue
+		}
+
+		if occurrence.From <= now.Unix() {
+			return "ongoing"
+		}
+		state = "upcoming"
+	}
+
+	return state
+}
+
+// formatUnix converts seconds since the epoch into an RFC 3339 timestamp.
+func formatUnix(seconds int64) string {
+	return time.Unix(seconds, 0).UTC().Format(time.RFC3339)
+}
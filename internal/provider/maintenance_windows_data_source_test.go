@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMaintenanceWindowsDataSource(t *testing.T) {
+	server := newTestServer(t)
+	config := testProviderConfig(server) + testMaintenanceWindowsConfig(time.Now())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The maintenance windows need to exist when the data sources are read.
+			{
+				Config: config,
+			},
+			{
+				Config: config + `
+data "pingdom_maintenance_windows" "all" {}
+
+data "pingdom_maintenance_windows" "ongoing" {
+  state = "ongoing"
+}
+
+data "pingdom_maintenance_windows" "upcoming" {
+  state = "upcoming"
+}
+
+data "pingdom_maintenance_windows" "past" {
+  state = "past"
+}
+
+data "pingdom_maintenance_windows" "backup" {
+  description = "backup"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_windows.all", "ids.#", "4"),
+					resource.TestCheckResourceAttrPair("data.pingdom_maintenance_windows.all", "ids.0", "pingdom_maintenance.past", "id"),
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_windows.all", "windows.0.description", "Release"),
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_windows.all", "windows.0.recurrence_type", "none"),
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_windows.all", "windows.0.state", "past"),
+					resource.TestCheckResourceAttrPair("data.pingdom_maintenance_windows.all", "windows.0.from", "pingdom_maintenance.past", "from"),
+					resource.TestCheckResourceAttrPair("data.pingdom_maintenance_windows.all", "windows.0.uptime_check_ids.0", "pingdom_http_check.test", "id"),
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_windows.all", "windows.0.transaction_check_ids.#", "0"),
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_windows.all", "windows.3.recurrence_type", "day"),
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_windows.all", "windows.3.repeat_every", "1"),
+					resource.TestCheckResourceAttrPair("data.pingdom_maintenance_windows.all", "windows.3.effective_to", "pingdom_maintenance.backup", "effective_to"),
+
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_windows.ongoing", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pingdom_maintenance_windows.ongoing", "ids.0", "pingdom_maintenance.ongoing", "id"),
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_windows.ongoing", "windows.0.state", "ongoing"),
+
+					// The recurring window is upcoming between its occurrences.
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_windows.upcoming", "ids.#", "2"),
+					resource.TestCheckResourceAttrPair("data.pingdom_maintenance_windows.upcoming", "ids.0", "pingdom_maintenance.upcoming", "id"),
+					resource.TestCheckResourceAttrPair("data.pingdom_maintenance_windows.upcoming", "ids.1", "pingdom_maintenance.backup", "id"),
+
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_windows.past", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pingdom_maintenance_windows.past", "ids.0", "pingdom_maintenance.past", "id"),
+
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_windows.backup", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.pingdom_maintenance_windows.backup", "windows.0.description", "Daily backup"),
+				),
+			},
+		},
+	})
+}
+
+// testMaintenanceWindowsConfig returns maintenance windows which are past,
+// ongoing and upcoming at the given time, and a daily one between two of its
+// occurrences.
+func testMaintenanceWindowsConfig(now time.Time) string {
+	at := func(offset time.Duration) string {
+		return now.Add(offset).UTC().Truncate(time.Second).Format(time.RFC3339)
+	}
+
+	return fmt.Sprintf(`
+resource "pingdom_http_check" "test" {
+  name      = "Example"
+  host      = "example.com"
+  frequency = "1m"
+  regions   = ["EU"]
+}
+
+resource "pingdom_maintenance" "past" {
+  description      = "Release"
+  from             = %q
+  to               = %q
+  uptime_check_ids = [pingdom_http_check.test.id]
+}
+
+resource "pingdom_maintenance" "ongoing" {
+  description = "Database upgrade"
+  from        = %q
+  to          = %q
+  depends_on  = [pingdom_maintenance.past]
+}
+
+resource "pingdom_maintenance" "upcoming" {
+  description = "Network maintenance"
+  from        = %q
+  to          = %q
+  depends_on  = [pingdom_maintenance.ongoing]
+}
+
+resource "pingdom_maintenance" "backup" {
+  description     = "Daily backup"
+  from            = %q
+  to              = %q
+  recurrence_type = "day"
+  repeat_every    = 1
+  effective_to    = %q
+  depends_on      = [pingdom_maintenance.upcoming]
+}
+`,
+		at(-48*time.Hour), at(-47*time.Hour),
+		at(-time.Hour), at(time.Hour),
+		at(24*time.Hour), at(25*time.Hour),
+		at(-26*time.Hour), at(-25*time.Hour), at(48*time.Hour),
+	)
+}
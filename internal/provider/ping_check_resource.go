@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PingCheckResource{}
+var _ resource.ResourceWithImportState = &PingCheckResource{}
+var _ resource.ResourceWithValidateConfig = &PingCheckResource{}
+
+func NewPingCheckResource() resource.Resource {
+	return &PingCheckResource{}
+}
+
+type PingCheckResource struct {
+	checkResource
+}
+
+type PingCheckResourceModel struct {
+	CheckModel
+}
+
+func (r *PingCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ping_check"
+}
+
+func (r *PingCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks whether a host answers to ICMP echo requests.",
+
+		Attributes: checkSchemaAttributes(map[string]schema.Attribute{}),
+	}
+}
+
+func (r *PingCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateCheckConfig(ctx, req.Config, &resp.Diagnostics)
+}
+
+func transformPingdomCheckToPingModel(check api_types.Check) (PingCheckResourceModel, diag.Diagnostics) {
+	checkModel, diagnostics := transformPingdomCheckToCheckModel(check)
+	if diagnostics.HasError() {
+		return PingCheckResourceModel{}, diagnostics
+	}
+
+	return PingCheckResourceModel{
+		CheckModel: checkModel,
+	}, nil
+}
+
+func createPingCheckRequestModel(resourceModel PingCheckResourceModel) api.CreateCheckRequest {
+	return resourceModel.createCheckRequest("ping")
+}
+
+func (r *PingCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model PingCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.createCheck(ctx, createPingCheckRequestModel(model), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToPingModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *PingCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model PingCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.readCheck(ctx, model.Id.ValueString(), "ping", resp)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToPingModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *PingCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PingCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.updateCheck(ctx, data.Id.ValueString(), createPingCheckRequestModel(data), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToPingModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
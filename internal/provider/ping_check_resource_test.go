@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPingCheckResource(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_ping_check" "test" {
+  name    = "Example"
+  host    = "lb.example.com"
+  ipv6    = true
+  regions = ["EU", "NA"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pingdom_ping_check.test", "id"),
+					resource.TestCheckResourceAttr("pingdom_ping_check.test", "name", "Example"),
+					resource.TestCheckResourceAttr("pingdom_ping_check.test", "host", "lb.example.com"),
+					resource.TestCheckResourceAttr("pingdom_ping_check.test", "ipv6", "true"),
+					resource.TestCheckResourceAttr("pingdom_ping_check.test", "regions.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pingdom_ping_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_ping_check" "test" {
+  name             = "Example"
+  host             = "lb.example.com"
+  paused           = true
+  frequency        = "1m"
+  notify_when_down = 3
+  tags             = { team = "network" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_ping_check.test", "ipv6", "false"),
+					resource.TestCheckResourceAttr("pingdom_ping_check.test", "paused", "true"),
+					resource.TestCheckResourceAttr("pingdom_ping_check.test", "frequency", "1m"),
+					resource.TestCheckResourceAttr("pingdom_ping_check.test", "notify_when_down", "3"),
+					resource.TestCheckResourceAttr("pingdom_ping_check.test", "tags.team", "network"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultPortFromEncryption sets the port to 443 or 80 depending on the
+// encryption attribute of the resource, unless a port is configured.
+func defaultPortFromEncryption() planmodifier.Int64 {
+	return defaultPortFromEncryptionModifier{}
+}
+
+type defaultPortFromEncryptionModifier struct{}
+
+func (m defaultPortFromEncryptionModifier) Description(_ context.Context) string {
+	return "Defaults to 443 if encryption is enabled and 80 otherwise."
+}
+
+func (m defaultPortFromEncryptionModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m defaultPortFromEncryptionModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var encryption types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("encryption"), &encryption)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case encryption.IsUnknown():
+		resp.PlanValue = types.Int64Unknown()
+	case encryption.ValueBool():
+		resp.PlanValue = types.Int64Value(443)
+	default:
+		resp.PlanValue = types.Int64Value(80)
+	}
+}
@@ -2,12 +2,23 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scayle/terraform-provider-pingdom/internal/api"
 )
@@ -33,7 +44,13 @@ type pingdomProvider struct {
 }
 
 type pingdomProviderModel struct {
-	ApiToken types.String `tfsdk:"api_token"`
+	ApiToken           types.String `tfsdk:"api_token"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	ApiURL             types.String `tfsdk:"api_url"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 }
 
 func (p *pingdomProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -54,6 +71,41 @@ The API token needs to have Read/Write permissions.
 See the Pingdom API documentation for more information: https://docs.pingdom.com/api/#section/Authentication.,
 `,
 			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf(`Maximum number of times a request is retried when the Pingdom API is throttling (429) or temporarily unavailable (5xx).
+
+Requests are retried with an exponential backoff and honour the `+"`Retry-After`"+` header. Set to 0 to disable retries. The default value is %d.`, api.DefaultMaxRetries),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"api_url": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf(`Base URL of the Pingdom API. Can also be set with the `+"`PINGDOM_API_URL`"+` environment variable.
+
+The default value is `+"`%s`"+`.`, api.DefaultBaseURL),
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Timeout of a single request to the Pingdom API as a duration, e.g. `30s` or `2m`. " +
+					"Can also be set with the `PINGDOM_REQUEST_TIMEOUT` environment variable. By default requests do not time out.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "URL of the proxy used for requests to the Pingdom API. Can also be set with the `PINGDOM_PROXY_URL` environment variable. " +
+					"If not set, the standard `HTTPS_PROXY` and `NO_PROXY` environment variables are respected.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Path to a PEM encoded CA bundle which is trusted in addition to the system certificates. " +
+					"Can also be set with the `PINGDOM_CA_CERT_FILE` environment variable.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Skip the verification of the TLS certificate of the Pingdom API. Only use this for testing. " +
+					"Can also be set with the `PINGDOM_INSECURE_SKIP_VERIFY` environment variable.",
+			},
 		},
 	}
 }
@@ -88,19 +140,134 @@ func (p *pingdomProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	client := api.New(apiToken)
+	var opts []api.Option
+	if !config.MaxRetries.IsNull() {
+		opts = append(opts, api.WithMaxRetries(int(config.MaxRetries.ValueInt64())))
+	}
+
+	apiURL := stringFromConfigOrEnv(config.ApiURL, "PINGDOM_API_URL")
+	if apiURL != "" {
+		if _, err := url.ParseRequestURI(apiURL); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("api_url"), "Invalid API URL", fmt.Sprintf("Unable to parse API URL: %s", err))
+			return
+		}
+		opts = append(opts, api.WithBaseURL(apiURL))
+	}
+
+	httpClient, diagnostics := newHTTPClient(config)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opts = append(opts, api.WithHTTPClient(httpClient))
+
+	client := api.New(apiToken, opts...)
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// stringFromConfigOrEnv returns the configured value, falling back to the
+// given environment variable if the attribute is not set.
+func stringFromConfigOrEnv(value types.String, env string) string {
+	if value.ValueString() != "" {
+		return value.ValueString()
+	}
+
+	return os.Getenv(env)
+}
+
+// newHTTPClient builds the HTTP client used to talk to the Pingdom API from
+// the timeout, proxy and TLS settings of the provider configuration.
+func newHTTPClient(config pingdomProviderModel) (*http.Client, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	// The default transport is only replaced by tests or instrumentation, in
+	// which case the provider falls back to the standard proxy handling.
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if ok {
+		transport = transport.Clone()
+	} else {
+		transport = &http.Transport{Proxy: http.ProxyFromEnvironment}
+	}
+	httpClient := &http.Client{Transport: transport}
+
+	if timeout := stringFromConfigOrEnv(config.RequestTimeout, "PINGDOM_REQUEST_TIMEOUT"); timeout != "" {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil || duration < 0 {
+			diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid Request Timeout", fmt.Sprintf("Unable to parse %q as a non-negative duration, e.g. 30s.", timeout))
+		} else {
+			httpClient.Timeout = duration
+		}
+	}
+
+	if proxyURL := stringFromConfigOrEnv(config.ProxyURL, "PINGDOM_PROXY_URL"); proxyURL != "" {
+		proxy, err := url.Parse(proxyURL)
+		if err != nil {
+			diagnostics.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL", fmt.Sprintf("Unable to parse proxy URL: %s", err))
+		} else {
+			transport.Proxy = http.ProxyURL(proxy)
+		}
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	transport.TLSClientConfig = tlsConfig
+
+	if caCertFile := stringFromConfigOrEnv(config.CACertFile, "PINGDOM_CA_CERT_FILE"); caCertFile != "" {
+		pem, err := os.ReadFile(caCertFile)
+		if err != nil {
+			diagnostics.AddAttributeError(path.Root("ca_cert_file"), "Unable to Read CA Bundle", err.Error())
+			return nil, diagnostics
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			diagnostics.AddAttributeError(path.Root("ca_cert_file"), "Invalid CA Bundle", fmt.Sprintf("No PEM encoded certificates found in %s.", caCertFile))
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	insecureSkipVerify := config.InsecureSkipVerify.ValueBool()
+	if config.InsecureSkipVerify.IsNull() {
+		if value := os.Getenv("PINGDOM_INSECURE_SKIP_VERIFY"); value != "" {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				diagnostics.AddError("Invalid Environment Variable", fmt.Sprintf("Unable to parse PINGDOM_INSECURE_SKIP_VERIFY=%q as a boolean.", value))
+			}
+			insecureSkipVerify = parsed
+		}
+	}
+	tlsConfig.InsecureSkipVerify = insecureSkipVerify
+
+	return httpClient, diagnostics
+}
+
 func (p *pingdomProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewContactDataSource,
+		NewTransactionCheckDataSource,
+		NewTransactionChecksDataSource,
+		NewTransactionCheckPerformanceReportDataSource,
+		NewTransactionCheckStatusReportDataSource,
+		NewMaintenanceWindowsDataSource,
+		NewMaintenanceOccurrencesDataSource,
 	}
 }
 
 func (p *pingdomProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewHTTPCheckResource,
+		NewTCPCheckResource,
+		NewPingCheckResource,
+		NewDNSCheckResource,
+		NewUDPCheckResource,
+		NewSMTPCheckResource,
+		NewPOP3CheckResource,
+		NewIMAPCheckResource,
+		NewHTTPCustomCheckResource,
+		NewTransactionCheckResource,
+		NewMaintenanceResource,
 	}
 }
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/scayle/terraform-provider-pingdom/internal/api/fake"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// acceptance testing. The factory function will be invoked for every Terraform
+// CLI command executed to create a provider server to which the CLI can
+// reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"pingdom": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// newTestServer starts a fake Pingdom API which is closed once the test
+// finished.
+func newTestServer(t *testing.T) *fake.Server {
+	t.Helper()
+
+	server := fake.NewServer()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// testProviderConfig returns a provider block pointing at the fake server.
+func testProviderConfig(server *fake.Server) string {
+	return fmt.Sprintf(`
+provider "pingdom" {
+  api_token   = "test-token"
+  api_url     = %q
+  max_retries = 0
+}
+`, server.APIURL())
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	dir := t.TempDir()
+	emptyPEM := filepath.Join(dir, "empty.pem")
+	if err := os.WriteFile(emptyPEM, []byte("no certificates here"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		config      pingdomProviderModel
+		env         map[string]string
+		wantTimeout time.Duration
+		wantError   string
+	}{
+		{
+			name:        "valid",
+			config:      pingdomProviderModel{RequestTimeout: types.StringValue("10s")},
+			wantTimeout: 10 * time.Second,
+		},
+		{
+			name:      "bad timeout",
+			config:    pingdomProviderModel{RequestTimeout: types.StringValue("ten seconds")},
+			wantError: "Invalid Request Timeout",
+		},
+		{
+			name:      "negative timeout",
+			env:       map[string]string{"PINGDOM_REQUEST_TIMEOUT": "-1s"},
+			wantError: "Invalid Request Timeout",
+		},
+		{
+			name:   "zero timeout",
+			config: pingdomProviderModel{RequestTimeout: types.StringValue("0s")},
+		},
+		{
+			name:      "unreadable CA file",
+			config:    pingdomProviderModel{CACertFile: types.StringValue(filepath.Join(dir, "missing.pem"))},
+			wantError: "Unable to Read CA Bundle",
+		},
+		{
+			name:      "CA file without certificates",
+			config:    pingdomProviderModel{CACertFile: types.StringValue(emptyPEM)},
+			wantError: "Invalid CA Bundle",
+		},
+		{
+			name:      "invalid PINGDOM_INSECURE_SKIP_VERIFY",
+			env:       map[string]string{"PINGDOM_INSECURE_SKIP_VERIFY": "maybe"},
+			wantError: "Invalid Environment Variable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, env := range []string{"PINGDOM_REQUEST_TIMEOUT", "PINGDOM_PROXY_URL", "PINGDOM_CA_CERT_FILE", "PINGDOM_INSECURE_SKIP_VERIFY"} {
+				t.Setenv(env, tt.env[env])
+			}
+
+			httpClient, diagnostics := newHTTPClient(tt.config)
+			if tt.wantError == "" {
+				if diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diagnostics)
+				}
+			} else if diagnostics.ErrorsCount() != 1 || diagnostics.Errors()[0].Summary() != tt.wantError {
+				t.Errorf("expected a single %q error, got %v", tt.wantError, diagnostics)
+			}
+
+			// Invalid timeouts must not be used.
+			if httpClient != nil && httpClient.Timeout != tt.wantTimeout {
+				t.Errorf("expected a timeout of %s, got %s", tt.wantTimeout, httpClient.Timeout)
+			}
+		})
+	}
+}
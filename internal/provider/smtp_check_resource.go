@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SMTPCheckResource{}
+var _ resource.ResourceWithImportState = &SMTPCheckResource{}
+var _ resource.ResourceWithValidateConfig = &SMTPCheckResource{}
+
+func NewSMTPCheckResource() resource.Resource {
+	return &SMTPCheckResource{}
+}
+
+type SMTPCheckResource struct {
+	checkResource
+}
+
+type SMTPCheckResourceModel struct {
+	CheckModel
+
+	Port           types.Int64     `tfsdk:"port"`
+	Encryption     types.Bool      `tfsdk:"encryption"`
+	Auth           *CheckAuthModel `tfsdk:"auth"`
+	StringToExpect types.String    `tfsdk:"string_to_expect"`
+}
+
+func (r *SMTPCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smtp_check"
+}
+
+func (r *SMTPCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks whether an SMTP server accepts connections and optionally logs in with the given credentials.",
+
+		Attributes: checkSchemaAttributes(map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port of the SMTP server. The default value is 25.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(25),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"encryption": schema.BoolAttribute{
+				MarkdownDescription: "Whether the connection is encrypted. The default value is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"auth": checkAuthSchemaAttribute("Credentials used to log in to the SMTP server."),
+			"string_to_expect": schema.StringAttribute{
+				MarkdownDescription: "Trigger a downtime if the response of the server does not contain this string.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		}),
+	}
+}
+
+func (r *SMTPCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateCheckConfig(ctx, req.Config, &resp.Diagnostics)
+}
+
+func transformPingdomCheckToSMTPModel(check api_types.Check, priorAuth *CheckAuthModel) (SMTPCheckResourceModel, diag.Diagnostics) {
+	checkModel, diagnostics := transformPingdomCheckToCheckModel(check)
+	if diagnostics.HasError() {
+		return SMTPCheckResourceModel{}, diagnostics
+	}
+
+	options := check.Type.SMTP
+
+	stringToExpect := types.StringNull()
+	if options.StringToExpect != "" {
+		stringToExpect = types.StringValue(options.StringToExpect)
+	}
+
+	return SMTPCheckResourceModel{
+		CheckModel: checkModel,
+
+		Port:           types.Int64Value(options.Port),
+		Encryption:     types.BoolValue(options.Encryption),
+		Auth:           transformPingdomAuthToModel(options.Username, options.Password, priorAuth),
+		StringToExpect: stringToExpect,
+	}, nil
+}
+
+func createSMTPCheckRequestModel(resourceModel SMTPCheckResourceModel) api.CreateCheckRequest {
+	body := resourceModel.createCheckRequest("smtp")
+	body.SMTP = &api.SMTPCheckRequest{
+		Port:           resourceModel.Port.ValueInt64(),
+		Encryption:     resourceModel.Encryption.ValueBool(),
+		Auth:           resourceModel.Auth.pingdomAuth(),
+		StringToExpect: resourceModel.StringToExpect.ValueString(),
+	}
+
+	return body
+}
+
+func (r *SMTPCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model SMTPCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(readWriteOnlyPassword(ctx, req.Config, model.Auth, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.createCheck(ctx, createSMTPCheckRequestModel(model), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToSMTPModel(*check, model.Auth)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *SMTPCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model SMTPCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.readCheck(ctx, model.Id.ValueString(), "smtp", resp)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToSMTPModel(*check, model.Auth)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *SMTPCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SMTPCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorAuth *CheckAuthModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("auth"), &priorAuth)...)
+	resp.Diagnostics.Append(readWriteOnlyPassword(ctx, req.Config, data.Auth, priorAuth)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.updateCheck(ctx, data.Id.ValueString(), createSMTPCheckRequestModel(data), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToSMTPModel(*check, data.Auth)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
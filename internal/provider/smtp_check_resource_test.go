@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scayle/terraform-provider-pingdom/internal/api/fake"
+)
+
+func TestAccSMTPCheckResource(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_smtp_check" "test" {
+  name = "Example"
+  host = "mail.example.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pingdom_smtp_check.test", "id"),
+					resource.TestCheckResourceAttr("pingdom_smtp_check.test", "port", "25"),
+					resource.TestCheckResourceAttr("pingdom_smtp_check.test", "encryption", "false"),
+					resource.TestCheckNoResourceAttr("pingdom_smtp_check.test", "auth.username"),
+					resource.TestCheckNoResourceAttr("pingdom_smtp_check.test", "string_to_expect"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_smtp_check" "test" {
+  name             = "Example"
+  host             = "mail.example.com"
+  port             = 465
+  encryption       = true
+  string_to_expect = "ESMTP"
+  auth = {
+    username = "monitoring"
+    password = "s3cret"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_smtp_check.test", "port", "465"),
+					resource.TestCheckResourceAttr("pingdom_smtp_check.test", "encryption", "true"),
+					resource.TestCheckResourceAttr("pingdom_smtp_check.test", "string_to_expect", "ESMTP"),
+					resource.TestCheckResourceAttr("pingdom_smtp_check.test", "auth.username", "monitoring"),
+					testCheckSMTPAuth(server, "pingdom_smtp_check.test", "monitoring", "s3cret"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pingdom_smtp_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing the credentials
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_smtp_check" "test" {
+  name       = "Example"
+  host       = "mail.example.com"
+  port       = 465
+  encryption = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("pingdom_smtp_check.test", "auth.username"),
+					testCheckSMTPAuth(server, "pingdom_smtp_check.test", "", ""),
+				),
+			},
+		},
+	})
+}
+
+// testCheckSMTPAuth verifies the credentials stored in the fake server.
+func testCheckSMTPAuth(server *fake.Server, resourceName, username, password string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testCheckID(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		check, ok := server.Check(id)
+		if !ok {
+			return fmt.Errorf("check %d not found", id)
+		}
+		if check.Type.SMTP.Username != username || check.Type.SMTP.Password != password {
+			return fmt.Errorf("expected credentials %q:%q, got %q:%q", username, password, check.Type.SMTP.Username, check.Type.SMTP.Password)
+		}
+
+		return nil
+	}
+}
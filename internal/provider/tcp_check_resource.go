@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TCPCheckResource{}
+var _ resource.ResourceWithImportState = &TCPCheckResource{}
+var _ resource.ResourceWithValidateConfig = &TCPCheckResource{}
+
+func NewTCPCheckResource() resource.Resource {
+	return &TCPCheckResource{}
+}
+
+type TCPCheckResource struct {
+	checkResource
+}
+
+type TCPCheckResourceModel struct {
+	CheckModel
+
+	Port           types.Int64  `tfsdk:"port"`
+	StringToSend   types.String `tfsdk:"string_to_send"`
+	StringToExpect types.String `tfsdk:"string_to_expect"`
+}
+
+func (r *TCPCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tcp_check"
+}
+
+func (r *TCPCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks whether a TCP port of a host accepts connections and optionally answers with the expected string.",
+
+		Attributes: checkSchemaAttributes(map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The TCP port to connect to.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"string_to_send": schema.StringAttribute{
+				MarkdownDescription: "A string which is sent to the port after the connection is established.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"string_to_expect": schema.StringAttribute{
+				MarkdownDescription: "Trigger a downtime if the response does not contain this string.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		}),
+	}
+}
+
+func (r *TCPCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateCheckConfig(ctx, req.Config, &resp.Diagnostics)
+}
+
+func transformPingdomCheckToTCPModel(check api_types.Check) (TCPCheckResourceModel, diag.Diagnostics) {
+	checkModel, diagnostics := transformPingdomCheckToCheckModel(check)
+	if diagnostics.HasError() {
+		return TCPCheckResourceModel{}, diagnostics
+	}
+
+	stringToSend := types.StringNull()
+	if check.Type.TCP.StringToSend != "" {
+		stringToSend = types.StringValue(check.Type.TCP.StringToSend)
+	}
+
+	stringToExpect := types.StringNull()
+	if check.Type.TCP.StringToExpect != "" {
+		stringToExpect = types.StringValue(check.Type.TCP.StringToExpect)
+	}
+
+	return TCPCheckResourceModel{
+		CheckModel: checkModel,
+
+		Port:           types.Int64Value(check.Type.TCP.Port),
+		StringToSend:   stringToSend,
+		StringToExpect: stringToExpect,
+	}, nil
+}
+
+func createTCPCheckRequestModel(resourceModel TCPCheckResourceModel) api.CreateCheckRequest {
+	body := resourceModel.createCheckRequest("tcp")
+	body.TCP = &api.TCPCheckRequest{
+		Port:           resourceModel.Port.ValueInt64(),
+		StringToSend:   resourceModel.StringToSend.ValueString(),
+		StringToExpect: resourceModel.StringToExpect.ValueString(),
+	}
+
+	return body
+}
+
+func (r *TCPCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model TCPCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.createCheck(ctx, createTCPCheckRequestModel(model), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToTCPModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TCPCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model TCPCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.readCheck(ctx, model.Id.ValueString(), "tcp", resp)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToTCPModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TCPCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TCPCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.updateCheck(ctx, data.Id.ValueString(), createTCPCheckRequestModel(data), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToTCPModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccTCPCheckResource(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_tcp_check" "test" {
+  name             = "Example"
+  host             = "mail.example.com"
+  port             = 25
+  string_to_send   = "HELO example.com"
+  string_to_expect = "250"
+  regions          = ["EU"]
+  tags             = { team = "platform" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pingdom_tcp_check.test", "id"),
+					resource.TestCheckResourceAttr("pingdom_tcp_check.test", "name", "Example"),
+					resource.TestCheckResourceAttr("pingdom_tcp_check.test", "host", "mail.example.com"),
+					resource.TestCheckResourceAttr("pingdom_tcp_check.test", "port", "25"),
+					resource.TestCheckResourceAttr("pingdom_tcp_check.test", "string_to_send", "HELO example.com"),
+					resource.TestCheckResourceAttr("pingdom_tcp_check.test", "string_to_expect", "250"),
+					resource.TestCheckResourceAttr("pingdom_tcp_check.test", "frequency", "5m"),
+					resource.TestCheckResourceAttr("pingdom_tcp_check.test", "regions.0", "EU"),
+					resource.TestCheckResourceAttr("pingdom_tcp_check.test", "tags.team", "platform"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pingdom_tcp_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_tcp_check" "test" {
+  name      = "Example"
+  host      = "mail.example.com"
+  port      = 587
+  frequency = "1m"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_tcp_check.test", "port", "587"),
+					resource.TestCheckNoResourceAttr("pingdom_tcp_check.test", "string_to_send"),
+					resource.TestCheckNoResourceAttr("pingdom_tcp_check.test", "string_to_expect"),
+					resource.TestCheckResourceAttr("pingdom_tcp_check.test", "frequency", "1m"),
+					resource.TestCheckResourceAttr("pingdom_tcp_check.test", "regions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTCPCheckResource_importOtherType(t *testing.T) {
+	server := newTestServer(t)
+
+	config := testProviderConfig(server) + `
+resource "pingdom_http_check" "test" {
+  name = "Example"
+  host = "example.com"
+}
+
+resource "pingdom_tcp_check" "test" {
+  name = "Example"
+  host = "example.com"
+  port = 22
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config:       config,
+				ResourceName: "pingdom_tcp_check.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["pingdom_http_check.test"].Primary.ID, nil
+				},
+				ExpectError: regexp.MustCompile(`use the pingdom_http_check resource`),
+			},
+		},
+	})
+}
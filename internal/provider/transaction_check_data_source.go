@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	"strconv"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TransactionCheckDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &TransactionCheckDataSource{}
+
+func NewTransactionCheckDataSource() datasource.DataSource {
+	return &TransactionCheckDataSource{}
+}
+
+type TransactionCheckDataSource struct {
+	client api.Client
+}
+
+type TransactionCheckDataSourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Paused types.Bool   `tfsdk:"paused"`
+	Type   types.String `tfsdk:"type"`
+	Status types.String `tfsdk:"status"`
+
+	Steps []TransactionCheckStepModel `tfsdk:"steps"`
+
+	Interval                 types.Int64  `tfsdk:"interval"`
+	Region                   types.String `tfsdk:"region"`
+	SeverityLevel            types.String `tfsdk:"severity_level"`
+	SendNotificationWhenDown types.Int64  `tfsdk:"send_notification_when_down"`
+	ContactIds               types.Set    `tfsdk:"contact_ids"`
+	TeamIds                  types.Set    `tfsdk:"team_ids"`
+
+	Tags types.Set `tfsdk:"tags"`
+}
+
+func (d *TransactionCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transaction_check"
+}
+
+func (d *TransactionCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a transaction check by its name or ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the transaction check. Either `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the transaction check. Either `id` or `name` must be set. The name must be unique.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"paused": schema.BoolAttribute{
+				MarkdownDescription: "Whether the check is paused.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the check, either script or recorded.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The current status of the check, e.g. successful, failing or unknown.",
+				Computed:            true,
+			},
+			"steps": schema.ListNestedAttribute{
+				MarkdownDescription: "The steps of the transaction, which are executed in order.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"fn": schema.StringAttribute{
+							MarkdownDescription: "The action of the step.",
+							Computed:            true,
+						},
+						"args": schema.MapAttribute{
+							MarkdownDescription: "The arguments of the action, which may include passwords and form values.",
+							ElementType:         types.StringType,
+							Computed:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+			"interval": schema.Int64Attribute{
+				MarkdownDescription: "How frequent the check runs in minutes.",
+				Computed:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region from which the check is performed.",
+				Computed:            true,
+			},
+			"severity_level": schema.StringAttribute{
+				MarkdownDescription: "The severity of the alerts.",
+				Computed:            true,
+			},
+			"send_notification_when_down": schema.Int64Attribute{
+				MarkdownDescription: "Notify the contacts when the check is down for X times.",
+				Computed:            true,
+			},
+			"contact_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the contacts that are notified.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the teams that are notified.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "The tags of the check.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TransactionCheckDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *TransactionCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TransactionCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TransactionCheckDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueString()
+	if data.Id.IsNull() {
+		// The list does not include the steps, so the check is looked up by
+		// name first and then read by its ID.
+		checks, err := d.client.GetTransactionChecks(ctx, api.GetTransactionChecksRequest{})
+		if err != nil {
+			addClientError(&resp.Diagnostics, "read transaction checks", err)
+			return
+		}
+
+		var ids []string
+		for _, check := range checks {
+			if check.Name == data.Name.ValueString() {
+				ids = append(ids, strconv.FormatInt(check.Id, 10))
+			}
+		}
+
+		switch len(ids) {
+		case 0:
+			resp.Diagnostics.AddError("Unable to find transaction check", fmt.Sprintf("Unable to find transaction check with name: %s", data.Name.ValueString()))
+			return
+		case 1:
+			id = ids[0]
+		default:
+			resp.Diagnostics.AddError(
+				"Multiple transaction checks found",
+				fmt.Sprintf("There are %d transaction checks with name %s, use the id attribute to select one of them.", len(ids), data.Name.ValueString()),
+			)
+			return
+		}
+	}
+
+	check, err := d.client.GetTransactionCheck(ctx, id)
+	if api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to find transaction check", fmt.Sprintf("Unable to find transaction check with ID: %s", id))
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read transaction check", err)
+		return
+	}
+
+	tflog.Info(ctx, "Transaction check found", map[string]interface{}{
+		"check.name": check.Name,
+		"check.id":   id,
+	})
+
+	model, diagnostics := transformPingdomTransactionCheckToModel(*check, nil)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	data = TransactionCheckDataSourceModel{
+		Id:     model.Id,
+		Name:   model.Name,
+		Paused: model.Paused,
+		Type:   types.StringValue(check.Type),
+		Status: types.StringValue(check.Status),
+
+		Steps: model.Steps,
+
+		Interval:                 model.Interval,
+		Region:                   model.Region,
+		SeverityLevel:            model.SeverityLevel,
+		SendNotificationWhenDown: model.SendNotificationWhenDown,
+		ContactIds:               model.ContactIds,
+		TeamIds:                  model.TeamIds,
+
+		Tags: model.Tags,
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
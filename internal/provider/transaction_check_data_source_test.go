@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scayle/terraform-provider-pingdom/internal/api/fake"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+// addTestTransactionCheck adds a transaction check owned by someone else to
+// the fake server.
+func addTestTransactionCheck(server *fake.Server, name, checkType, status string, tags ...string) int64 {
+	return server.AddTransactionCheck(api_types.TransactionCheck{
+		Name:                     name,
+		Active:                   true,
+		Type:                     checkType,
+		Status:                   status,
+		Interval:                 10,
+		Region:                   "eu",
+		SeverityLevel:            "high",
+		SendNotificationWhenDown: 1,
+		ContactIds:               []int64{42},
+		Tags:                     tags,
+		Steps: []api_types.TransactionCheckStep{
+			{Fn: "go_to", Args: map[string]string{"url": "https://example.com"}},
+			{Fn: "exists", Args: map[string]string{"element": "#" + name}},
+		},
+	})
+}
+
+func TestAccTransactionCheckDataSource(t *testing.T) {
+	server := newTestServer(t)
+	addTestTransactionCheck(server, "Checkout", "script", "successful")
+	id := addTestTransactionCheck(server, "Login", "script", "failing", "shop")
+	addTestTransactionCheck(server, "Duplicate", "script", "unknown")
+	addTestTransactionCheck(server, "Duplicate", "recorded", "unknown")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+data "pingdom_transaction_check" "by_name" {
+  name = "Login"
+}
+
+data "pingdom_transaction_check" "by_id" {
+  id = data.pingdom_transaction_check.by_name.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check.by_name", "id", strconv.FormatInt(id, 10)),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check.by_name", "status", "failing"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check.by_name", "type", "script"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check.by_name", "region", "eu"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check.by_name", "interval", "10"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check.by_name", "steps.#", "2"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check.by_name", "steps.1.args.element", "#Login"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check.by_name", "contact_ids.0", "42"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check.by_name", "tags.0", "shop"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check.by_id", "name", "Login"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check.by_id", "steps.0.fn", "go_to"),
+				),
+			},
+			{
+				Config: testProviderConfig(server) + `
+data "pingdom_transaction_check" "test" {
+  name = "Nobody"
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to find transaction check with name"),
+			},
+			{
+				Config: testProviderConfig(server) + `
+data "pingdom_transaction_check" "test" {
+  name = "Duplicate"
+}
+`,
+				ExpectError: regexp.MustCompile("There are 2 transaction checks with name Duplicate"),
+			},
+			{
+				Config: testProviderConfig(server) + `
+data "pingdom_transaction_check" "test" {
+  id = "999999"
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to find transaction check with ID"),
+			},
+		},
+	})
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TransactionCheckPerformanceReportDataSource{}
+
+func NewTransactionCheckPerformanceReportDataSource() datasource.DataSource {
+	return &TransactionCheckPerformanceReportDataSource{}
+}
+
+type TransactionCheckPerformanceReportDataSource struct {
+	client api.Client
+}
+
+type TransactionCheckPerformanceReportDataSourceModel struct {
+	CheckId    types.String `tfsdk:"check_id"`
+	From       types.String `tfsdk:"from"`
+	To         types.String `tfsdk:"to"`
+	Resolution types.String `tfsdk:"resolution"`
+
+	Name      types.String                               `tfsdk:"name"`
+	Intervals []TransactionCheckPerformanceIntervalModel `tfsdk:"intervals"`
+}
+
+type TransactionCheckPerformanceIntervalModel struct {
+	From            types.String                           `tfsdk:"from"`
+	AverageResponse types.Int64                            `tfsdk:"average_response"`
+	Uptime          types.Int64                            `tfsdk:"uptime"`
+	Downtime        types.Int64                            `tfsdk:"downtime"`
+	Unmonitored     types.Int64                            `tfsdk:"unmonitored"`
+	Steps           []TransactionCheckStepPerformanceModel `tfsdk:"steps"`
+}
+
+type TransactionCheckStepPerformanceModel struct {
+	Fn              types.String `tfsdk:"fn"`
+	Args            types.Map    `tfsdk:"args"`
+	AverageResponse types.Int64  `tfsdk:"average_response"`
+}
+
+func (d *TransactionCheckPerformanceReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transaction_check_performance_report"
+}
+
+func (d *TransactionCheckPerformanceReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Average response times and uptime of a transaction check per interval, including the timings of the single steps.",
+
+		Attributes: map[string]schema.Attribute{
+			"check_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the transaction check.",
+				Required:            true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "The start of the report in RFC 3339 format, e.g. `2025-01-01T00:00:00Z`.",
+				Required:            true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "The end of the report in RFC 3339 format, e.g. `2025-02-01T00:00:00Z`.",
+				Required:            true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"resolution": schema.StringAttribute{
+				MarkdownDescription: "The length of the intervals. Allowed values are: hour, day and week. The default value is hour.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("hour", "day", "week"),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the transaction check.",
+				Computed:            true,
+			},
+			"intervals": schema.ListNestedAttribute{
+				MarkdownDescription: "The intervals of the report, ordered by time.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"from": schema.StringAttribute{
+							MarkdownDescription: "The start of the interval in RFC 3339 format.",
+							Computed:            true,
+						},
+						"average_response": schema.Int64Attribute{
+							MarkdownDescription: "The average response time of the whole transaction in milliseconds.",
+							Computed:            true,
+						},
+						"uptime": schema.Int64Attribute{
+							MarkdownDescription: "The time the check was up in seconds.",
+							Computed:            true,
+						},
+						"downtime": schema.Int64Attribute{
+							MarkdownDescription: "The time the check was down in seconds.",
+							Computed:            true,
+						},
+						"unmonitored": schema.Int64Attribute{
+							MarkdownDescription: "The time the check was not monitored in seconds, e.g. because it was paused.",
+							Computed:            true,
+						},
+						"steps": schema.ListNestedAttribute{
+							MarkdownDescription: "The average response time of each step.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"fn": schema.StringAttribute{
+										MarkdownDescription: "The action of the step.",
+										Computed:            true,
+									},
+									"args": schema.MapAttribute{
+										MarkdownDescription: "The arguments of the action, which may include passwords and form values.",
+										ElementType:         types.StringType,
+										Computed:            true,
+										Sensitive:           true,
+									},
+									"average_response": schema.Int64Attribute{
+										MarkdownDescription: "The average response time of the step in milliseconds.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TransactionCheckPerformanceReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TransactionCheckPerformanceReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TransactionCheckPerformanceReportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from, to := parseReportRange(data.From, data.To, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Resolution.IsNull() {
+		data.Resolution = types.StringValue("hour")
+	}
+
+	report, err := d.client.GetTransactionCheckPerformanceReport(ctx, data.CheckId.ValueString(), api.GetTransactionCheckPerformanceReportRequest{
+		From:       from,
+		To:         to,
+		Resolution: data.Resolution.ValueString(),
+	})
+	if api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to find transaction check", fmt.Sprintf("Unable to find transaction check with ID: %s", data.CheckId.ValueString()))
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read transaction check performance report", err)
+		return
+	}
+
+	data.Name = types.StringValue(report.Name)
+	data.Intervals = []TransactionCheckPerformanceIntervalModel{}
+	for _, interval := range report.Intervals {
+		steps := []TransactionCheckStepPerformanceModel{}
+		for _, step := range interval.Steps {
+			args, diagnostics := types.MapValueFrom(ctx, types.StringType, step.Step.Args)
+			resp.Diagnostics.Append(diagnostics...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			steps = append(steps, TransactionCheckStepPerformanceModel{
+				Fn:              types.StringValue(step.Step.Fn),
+				Args:            args,
+				AverageResponse: types.Int64Value(step.AverageResponse),
+			})
+		}
+
+		data.Intervals = append(data.Intervals, TransactionCheckPerformanceIntervalModel{
+			From:            types.StringValue(interval.From.UTC().Format(time.RFC3339)),
+			AverageResponse: types.Int64Value(interval.AverageResponse),
+			Uptime:          types.Int64Value(interval.Uptime),
+			Downtime:        types.Int64Value(interval.Downtime),
+			Unmonitored:     types.Int64Value(interval.Unmonitored),
+			Steps:           steps,
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseReportRange parses the from and to attributes of a report, which have
+// already been validated to be RFC 3339 timestamps.
+func parseReportRange(fromValue, toValue types.String, diagnostics *diag.Diagnostics) (time.Time, time.Time) {
+	from, err := time.Parse(time.RFC3339, fromValue.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid Report Range", fmt.Sprintf("Unable to parse from: %s", err))
+		return time.Time{}, time.Time{}
+	}
+
+	to, err := time.Parse(time.RFC3339, toValue.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid Report Range", fmt.Sprintf("Unable to parse to: %s", err))
+		return time.Time{}, time.Time{}
+	}
+
+	if !from.Before(to) {
+		diagnostics.AddError("Invalid Report Range", fmt.Sprintf("The start of the report %s must be before its end %s.", fromValue.ValueString(), toValue.ValueString()))
+	}
+
+	return from, to
+}
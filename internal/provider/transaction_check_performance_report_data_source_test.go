@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+func TestAccTransactionCheckPerformanceReportDataSource(t *testing.T) {
+	server := newTestServer(t)
+	id := addTestTransactionCheck(server, "Login", "script", "successful")
+
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, averageResponse := range []int64{1200, 1500, 900} {
+		server.AddTransactionCheckPerformance(id, "day", api_types.TransactionCheckPerformanceInterval{
+			From:            day.AddDate(0, 0, i),
+			AverageResponse: averageResponse,
+			Uptime:          86000,
+			Downtime:        400,
+			Steps: []api_types.TransactionCheckStepPerformance{
+				{AverageResponse: averageResponse - 100, Step: api_types.TransactionCheckStep{Fn: "go_to", Args: map[string]string{"url": "https://example.com"}}},
+				{AverageResponse: 100, Step: api_types.TransactionCheckStep{Fn: "exists", Args: map[string]string{"element": "#Login"}}},
+			},
+		})
+	}
+	server.AddTransactionCheckPerformance(id, "hour", api_types.TransactionCheckPerformanceInterval{From: day, AverageResponse: 1000, Uptime: 3600})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+data "pingdom_transaction_check_performance_report" "test" {
+  check_id = "` + strconv.FormatInt(id, 10) + `"
+  from     = "2025-01-02T00:00:00Z"
+  to       = "2025-01-01T00:00:00Z"
+}
+`,
+				ExpectError: regexp.MustCompile("must be before its end"),
+			},
+			{
+				Config: testProviderConfig(server) + `
+data "pingdom_transaction_check_performance_report" "test" {
+  check_id = "` + strconv.FormatInt(id, 10) + `"
+  from     = "2025-01-01"
+  to       = "2025-01-02T00:00:00Z"
+}
+`,
+				ExpectError: regexp.MustCompile("not a timestamp in RFC 3339 format"),
+			},
+			{
+				Config: testProviderConfig(server) + `
+data "pingdom_transaction_check_performance_report" "daily" {
+  check_id   = "` + strconv.FormatInt(id, 10) + `"
+  from       = "2025-01-01T01:00:00+01:00"
+  to         = "2025-01-03T00:00:00Z"
+  resolution = "day"
+}
+
+data "pingdom_transaction_check_performance_report" "hourly" {
+  check_id = "` + strconv.FormatInt(id, 10) + `"
+  from     = "2025-01-01T00:00:00Z"
+  to       = "2025-01-02T00:00:00Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_performance_report.daily", "name", "Login"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_performance_report.daily", "intervals.#", "2"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_performance_report.daily", "intervals.0.from", "2025-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_performance_report.daily", "intervals.1.from", "2025-01-02T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_performance_report.daily", "intervals.1.average_response", "1500"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_performance_report.daily", "intervals.1.uptime", "86000"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_performance_report.daily", "intervals.1.downtime", "400"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_performance_report.daily", "intervals.1.steps.0.fn", "go_to"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_performance_report.daily", "intervals.1.steps.0.average_response", "1400"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_performance_report.daily", "intervals.1.steps.1.args.element", "#Login"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_performance_report.hourly", "resolution", "hour"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_performance_report.hourly", "intervals.#", "1"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_performance_report.hourly", "intervals.0.average_response", "1000"),
+				),
+			},
+		},
+	})
+}
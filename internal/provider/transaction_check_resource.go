@@ -0,0 +1,620 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TransactionCheckResource{}
+var _ resource.ResourceWithImportState = &TransactionCheckResource{}
+var _ resource.ResourceWithValidateConfig = &TransactionCheckResource{}
+
+func NewTransactionCheckResource() resource.Resource {
+	return &TransactionCheckResource{}
+}
+
+type TransactionCheckResource struct {
+	client api.Client
+}
+
+type TransactionCheckResourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Paused types.Bool   `tfsdk:"paused"`
+
+	Steps []TransactionCheckStepModel `tfsdk:"steps"`
+
+	Interval      types.Int64  `tfsdk:"interval"`
+	Region        types.String `tfsdk:"region"`
+	SeverityLevel types.String `tfsdk:"severity_level"`
+	// Send notification when down X times
+	SendNotificationWhenDown types.Int64 `tfsdk:"send_notification_when_down"`
+	ContactIds               types.Set   `tfsdk:"contact_ids"`
+	TeamIds                  types.Set   `tfsdk:"team_ids"`
+
+	Tags types.Set `tfsdk:"tags"`
+
+	Metadata *TransactionCheckMetadataModel `tfsdk:"metadata"`
+}
+
+type TransactionCheckStepModel struct {
+	Fn   types.String `tfsdk:"fn"`
+	Args types.Map    `tfsdk:"args"`
+}
+
+type TransactionCheckMetadataModel struct {
+	Width               types.Int64                               `tfsdk:"width"`
+	Height              types.Int64                               `tfsdk:"height"`
+	DisableWebSecurity  types.Bool                                `tfsdk:"disable_web_security"`
+	HTTPAuthentications []TransactionCheckHTTPAuthenticationModel `tfsdk:"http_authentications"`
+}
+
+type TransactionCheckHTTPAuthenticationModel struct {
+	Host     types.String `tfsdk:"host"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+var transactionCheckHTTPAuthenticationType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"host":     types.StringType,
+		"username": types.StringType,
+		"password": types.StringType,
+	},
+}
+
+func (r *TransactionCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transaction_check"
+}
+
+func (r *TransactionCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	stepFns := make([]string, 0, len(api_types.TransactionCheckStepArgs))
+	for fn := range api_types.TransactionCheckStepArgs {
+		stepFns = append(stepFns, fn)
+	}
+	sort.Strings(stepFns)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Transaction check which runs a sequence of browser steps, e.g. to log in or to complete a checkout.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the transaction check in Pingdom.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the check.",
+				Required:            true,
+			},
+			"paused": schema.BoolAttribute{
+				MarkdownDescription: "Whether the check is paused.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+
+			"steps": schema.ListNestedAttribute{
+				MarkdownDescription: "The steps of the transaction, which are executed in order.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"fn": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The action of the step. Allowed values are: %s.", strings.Join(stepFns, ", ")),
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(stepFns...),
+							},
+						},
+						"args": schema.MapAttribute{
+							MarkdownDescription: "The arguments of the action, e.g. `url` for `go_to` or `element` and `value` for `contains_text`. " +
+								"The arguments are sensitive, as they include the `password` of `basic_auth` and the values filled into forms, but are stored in the Terraform state.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+
+			"interval": schema.Int64Attribute{
+				MarkdownDescription: "Define how frequent the check should run in minutes. Allowed values are: 5, 10, 20, 60, 720 and 1440. The default value is 10.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.OneOf(5, 10, 20, 60, 720, 1440),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region from which the check is performed. Allowed values are: us-east, us-west, eu and au. The default value is us-east.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("us-east"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("us-east", "us-west", "eu", "au"),
+				},
+			},
+			"severity_level": schema.StringAttribute{
+				MarkdownDescription: "The severity of the alerts. Allowed values are: high and low. The default value is high.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("high"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("high", "low"),
+				},
+			},
+			"send_notification_when_down": schema.Int64Attribute{
+				MarkdownDescription: "Notify the contacts when the check is down for X times. The default value is 1.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"contact_ids": schema.SetAttribute{
+				MarkdownDescription: "A list of contact IDs that will be notified.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a numeric ID"),
+					),
+				},
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: "A list of team IDs that will be notified.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a numeric ID"),
+					),
+				},
+			},
+
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "A list of tags for the check.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+
+			"metadata": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of the browser which runs the steps.",
+				Optional:            true,
+				Computed:            true,
+				Default: objectdefault.StaticValue(types.ObjectValueMust(
+					map[string]attr.Type{
+						"width":                types.Int64Type,
+						"height":               types.Int64Type,
+						"disable_web_security": types.BoolType,
+						"http_authentications": types.ListType{ElemType: transactionCheckHTTPAuthenticationType},
+					},
+					map[string]attr.Value{
+						"width":                types.Int64Value(1950),
+						"height":               types.Int64Value(1080),
+						"disable_web_security": types.BoolValue(false),
+						"http_authentications": types.ListValueMust(transactionCheckHTTPAuthenticationType, []attr.Value{}),
+					},
+				)),
+				Attributes: map[string]schema.Attribute{
+					"width": schema.Int64Attribute{
+						MarkdownDescription: "The width of the browser window in pixels. The default value is 1950.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(1950),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"height": schema.Int64Attribute{
+						MarkdownDescription: "The height of the browser window in pixels. The default value is 1080.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(1080),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"disable_web_security": schema.BoolAttribute{
+						MarkdownDescription: "Disable the same-origin policy and other security features of the browser. The default value is false.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"http_authentications": schema.ListNestedAttribute{
+						MarkdownDescription: "Basic auth credentials the browser uses for the given hosts.",
+						Optional:            true,
+						Computed:            true,
+						Default:             listdefault.StaticValue(types.ListValueMust(transactionCheckHTTPAuthenticationType, []attr.Value{})),
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"host": schema.StringAttribute{
+									MarkdownDescription: "The host the credentials are used for, e.g. `https://shop.example.com`.",
+									Required:            true,
+								},
+								"username": schema.StringAttribute{
+									MarkdownDescription: "The username used to authenticate.",
+									Required:            true,
+								},
+								"password": schema.StringAttribute{
+									MarkdownDescription: "The password used to authenticate.",
+									Required:            true,
+									Sensitive:           true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TransactionCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var steps types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("steps"), &steps)...)
+	if resp.Diagnostics.HasError() || steps.IsNull() || steps.IsUnknown() {
+		return
+	}
+
+	for i, element := range steps.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+
+		var step TransactionCheckStepModel
+		resp.Diagnostics.Append(object.As(ctx, &step, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if step.Fn.IsUnknown() || step.Args.IsUnknown() {
+			continue
+		}
+
+		args := step.Args.Elements()
+		for _, arg := range api_types.TransactionCheckStepArgs[step.Fn.ValueString()] {
+			if _, ok := args[arg]; !ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("steps").AtListIndex(i).AtName("args"),
+					"Missing Step Argument",
+					fmt.Sprintf("The step action %s requires the argument %q.", step.Fn.ValueString(), arg),
+				)
+			}
+		}
+	}
+}
+
+func (r *TransactionCheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// transformPingdomTransactionCheckToModel converts the transaction check
+// returned by Pingdom into the resource model. The prior metadata from the
+// plan or state is used to keep passwords which are not returned by Pingdom.
+func transformPingdomTransactionCheckToModel(check api_types.TransactionCheck, priorMetadata *TransactionCheckMetadataModel) (TransactionCheckResourceModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	steps := []TransactionCheckStepModel{}
+	for _, step := range check.Steps {
+		args := types.MapNull(types.StringType)
+		if len(step.Args) > 0 {
+			var stepDiagnostics diag.Diagnostics
+			args, stepDiagnostics = types.MapValueFrom(context.Background(), types.StringType, step.Args)
+			diagnostics.Append(stepDiagnostics...)
+		}
+
+		steps = append(steps, TransactionCheckStepModel{
+			Fn:   types.StringValue(step.Fn),
+			Args: args,
+		})
+	}
+
+	priorPasswords := map[string]types.String{}
+	if priorMetadata != nil {
+		for _, authentication := range priorMetadata.HTTPAuthentications {
+			priorPasswords[authentication.Host.ValueString()] = authentication.Password
+		}
+	}
+
+	httpAuthentications := []TransactionCheckHTTPAuthenticationModel{}
+	for _, authentication := range check.Metadata.Authentications.HTTPAuthentications {
+		password := types.StringValue(authentication.Password)
+		if prior, ok := priorPasswords[authentication.Host]; ok && authentication.Password == "" {
+			// Pingdom does not always return the password.
+			password = prior
+		}
+
+		httpAuthentications = append(httpAuthentications, TransactionCheckHTTPAuthenticationModel{
+			Host:     types.StringValue(authentication.Host),
+			Username: types.StringValue(authentication.UserName),
+			Password: password,
+		})
+	}
+
+	contactIds, contactIdsDiagnostics := types.SetValueFrom(context.Background(), types.StringType, formatIDs(check.ContactIds))
+	diagnostics.Append(contactIdsDiagnostics...)
+
+	teamIds, teamIdsDiagnostics := types.SetValueFrom(context.Background(), types.StringType, formatIDs(check.TeamIds))
+	diagnostics.Append(teamIdsDiagnostics...)
+
+	tags := check.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	tfTags, tagsDiagnostics := types.SetValueFrom(context.Background(), types.StringType, tags)
+	diagnostics.Append(tagsDiagnostics...)
+
+	if diagnostics.HasError() {
+		return TransactionCheckResourceModel{}, diagnostics
+	}
+
+	return TransactionCheckResourceModel{
+		Id:     types.StringValue(strconv.FormatInt(check.Id, 10)),
+		Name:   types.StringValue(check.Name),
+		Paused: types.BoolValue(!check.Active),
+
+		Steps: steps,
+
+		Interval:                 types.Int64Value(check.Interval),
+		Region:                   types.StringValue(check.Region),
+		SeverityLevel:            types.StringValue(check.SeverityLevel),
+		SendNotificationWhenDown: types.Int64Value(check.SendNotificationWhenDown),
+		ContactIds:               contactIds,
+		TeamIds:                  teamIds,
+
+		Tags: tfTags,
+
+		Metadata: &TransactionCheckMetadataModel{
+			Width:               types.Int64Value(check.Metadata.Width),
+			Height:              types.Int64Value(check.Metadata.Height),
+			DisableWebSecurity:  types.BoolValue(check.Metadata.DisableWebSecurity),
+			HTTPAuthentications: httpAuthentications,
+		},
+	}, nil
+}
+
+func createTransactionCheckRequestModel(resourceModel TransactionCheckResourceModel) api.CreateTransactionCheckRequest {
+	steps := []api_types.TransactionCheckStep{}
+	for _, step := range resourceModel.Steps {
+		args := map[string]string{}
+		for key, value := range step.Args.Elements() {
+			stringValue, ok := value.(types.String)
+			if !ok {
+				continue
+			}
+
+			args[key] = stringValue.ValueString()
+		}
+
+		steps = append(steps, api_types.TransactionCheckStep{
+			Fn:   step.Fn.ValueString(),
+			Args: args,
+		})
+	}
+
+	metadata := api_types.TransactionCheckMetadata{
+		Authentications: api_types.TransactionCheckAuthentications{
+			HTTPAuthentications: []api_types.TransactionCheckHTTPAuthentication{},
+		},
+	}
+	if resourceModel.Metadata != nil {
+		metadata.Width = resourceModel.Metadata.Width.ValueInt64()
+		metadata.Height = resourceModel.Metadata.Height.ValueInt64()
+		metadata.DisableWebSecurity = resourceModel.Metadata.DisableWebSecurity.ValueBool()
+
+		for _, authentication := range resourceModel.Metadata.HTTPAuthentications {
+			metadata.Authentications.HTTPAuthentications = append(metadata.Authentications.HTTPAuthentications, api_types.TransactionCheckHTTPAuthentication{
+				Host:     authentication.Host.ValueString(),
+				UserName: authentication.Username.ValueString(),
+				Password: authentication.Password.ValueString(),
+			})
+		}
+	}
+
+	tags := []string{}
+	for _, tag := range resourceModel.Tags.Elements() {
+		stringValue, ok := tag.(types.String)
+		if !ok {
+			continue
+		}
+
+		tags = append(tags, stringValue.ValueString())
+	}
+
+	return api.CreateTransactionCheckRequest{
+		Name:                     resourceModel.Name.ValueString(),
+		Active:                   !resourceModel.Paused.ValueBool(),
+		Interval:                 resourceModel.Interval.ValueInt64(),
+		Region:                   resourceModel.Region.ValueString(),
+		SeverityLevel:            resourceModel.SeverityLevel.ValueString(),
+		SendNotificationWhenDown: resourceModel.SendNotificationWhenDown.ValueInt64(),
+		ContactIds:               parseIDs(resourceModel.ContactIds),
+		TeamIds:                  parseIDs(resourceModel.TeamIds),
+		Tags:                     tags,
+		Steps:                    steps,
+		Metadata:                 metadata,
+	}
+}
+
+// formatIDs converts numeric Pingdom IDs to strings.
+func formatIDs(ids []int64) []string {
+	formatted := []string{}
+	for _, id := range ids {
+		formatted = append(formatted, strconv.FormatInt(id, 10))
+	}
+
+	return formatted
+}
+
+// parseIDs converts a set of strings to numeric Pingdom IDs. The values are
+// validated by the schema, so invalid values are skipped.
+func parseIDs(set types.Set) []int64 {
+	ids := []int64{}
+	for _, value := range set.Elements() {
+		stringValue, ok := value.(types.String)
+		if !ok {
+			continue
+		}
+
+		id, err := strconv.ParseInt(stringValue.ValueString(), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func (r *TransactionCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model TransactionCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check, err := r.client.CreateTransactionCheck(ctx, createTransactionCheckRequestModel(model))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create transaction check", err)
+		return
+	}
+
+	model, diagnostics := transformPingdomTransactionCheckToModel(*check, model.Metadata)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TransactionCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model TransactionCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check, err := r.client.GetTransactionCheck(ctx, model.Id.ValueString())
+	if api.IsNotFound(err) {
+		tflog.Warn(ctx, "Transaction check not found, removing it from state", map[string]interface{}{
+			"check.id": model.Id.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read transaction check", err)
+		return
+	}
+
+	model, diagnostics := transformPingdomTransactionCheckToModel(*check, model.Metadata)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TransactionCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TransactionCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check, err := r.client.UpdateTransactionCheck(ctx, data.Id.ValueString(), createTransactionCheckRequestModel(data))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update transaction check", err)
+		return
+	}
+
+	model, diagnostics := transformPingdomTransactionCheckToModel(*check, data.Metadata)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TransactionCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTransactionCheck(ctx, id.ValueString())
+	if api.IsNotFound(err) {
+		// The check is already gone, which is what we wanted.
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "delete transaction check", err)
+		return
+	}
+}
+
+func (r *TransactionCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scayle/terraform-provider-pingdom/internal/api/fake"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+func TestAccTransactionCheckResource(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_transaction_check" "test" {
+  name           = "Login"
+  interval       = 5
+  region         = "eu"
+  severity_level = "low"
+  contact_ids    = ["123", "456"]
+  team_ids       = ["7"]
+  tags           = ["shop", "login"]
+
+  steps = [
+    { fn = "go_to", args = { url = "https://shop.example.com/login" } },
+    { fn = "fill", args = { input = "#email", value = "monitoring@example.com" } },
+    { fn = "click", args = { element = "#submit" } },
+    { fn = "contains_text", args = { element = "h1", value = "Welcome" } },
+  ]
+
+  metadata = {
+    width  = 1280
+    height = 720
+    http_authentications = [
+      { host = "https://shop.example.com", username = "stage", password = "secret" },
+    ]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pingdom_transaction_check.test", "id"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "name", "Login"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "paused", "false"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "interval", "5"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "region", "eu"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "severity_level", "low"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "send_notification_when_down", "1"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "contact_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("pingdom_transaction_check.test", "team_ids.*", "7"),
+					resource.TestCheckTypeSetElemAttr("pingdom_transaction_check.test", "tags.*", "login"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "steps.#", "4"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "steps.0.fn", "go_to"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "steps.0.args.url", "https://shop.example.com/login"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "steps.3.args.value", "Welcome"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "metadata.width", "1280"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "metadata.height", "720"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "metadata.disable_web_security", "false"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "metadata.http_authentications.0.username", "stage"),
+					testCheckTransactionCheck(server, "pingdom_transaction_check.test", func(check api_types.TransactionCheck) error {
+						if len(check.Steps) != 4 || check.Steps[2].Fn != "click" || check.Steps[2].Args["element"] != "#submit" {
+							return fmt.Errorf("unexpected steps %+v", check.Steps)
+						}
+						if check.Metadata.Authentications.HTTPAuthentications[0].Password != "secret" {
+							return fmt.Errorf("unexpected http authentications %+v", check.Metadata.Authentications.HTTPAuthentications)
+						}
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pingdom_transaction_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_transaction_check" "test" {
+  name   = "Login"
+  paused = true
+
+  steps = [
+    { fn = "go_to", args = { url = "https://shop.example.com" } },
+    { fn = "exists", args = { element = "#login" } },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "paused", "true"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "interval", "10"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "region", "us-east"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "severity_level", "high"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "contact_ids.#", "0"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "team_ids.#", "0"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "tags.#", "0"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "steps.#", "2"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "steps.1.fn", "exists"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "metadata.width", "1950"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "metadata.height", "1080"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "metadata.http_authentications.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTransactionCheckResource_drift(t *testing.T) {
+	server := newTestServer(t)
+
+	config := testProviderConfig(server) + `
+resource "pingdom_transaction_check" "test" {
+  name = "Example"
+
+  steps = [
+    { fn = "go_to", args = { url = "https://example.com" } },
+  ]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// Changes made in the Pingdom UI are detected and reverted.
+			{
+				Config: config,
+				Check: testCheckModifyTransactionCheck(server, "pingdom_transaction_check.test", func(check *api_types.TransactionCheck) {
+					check.Steps = append(check.Steps, api_types.TransactionCheckStep{Fn: "click", Args: map[string]string{"element": "#accept"}})
+					check.Region = "au"
+				}),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "steps.#", "1"),
+					resource.TestCheckResourceAttr("pingdom_transaction_check.test", "region", "us-east"),
+				),
+			},
+			// Checks deleted in the Pingdom UI are created again.
+			{
+				Config:             config,
+				Check:              testCheckDeleteTransactionCheck(server, "pingdom_transaction_check.test"),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttrSet("pingdom_transaction_check.test", "id"),
+			},
+		},
+	})
+}
+
+func TestAccTransactionCheckResource_missingStepArgument(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_transaction_check" "test" {
+  name = "Example"
+
+  steps = [
+    { fn = "go_to", args = { url = "https://example.com" } },
+    { fn = "fill", args = { input = "#email" } },
+  ]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`requires the argument "value"`),
+			},
+		},
+	})
+}
+
+// testCheckTransactionCheck runs assertions on the transaction check stored
+// in the fake server.
+func testCheckTransactionCheck(server *fake.Server, resourceName string, check func(check api_types.TransactionCheck) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testCheckID(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		transactionCheck, ok := server.TransactionCheck(id)
+		if !ok {
+			return fmt.Errorf("transaction check %d not found", id)
+		}
+
+		return check(transactionCheck)
+	}
+}
+
+// testCheckModifyTransactionCheck changes the transaction check on the fake
+// server, as if it was edited in the Pingdom UI.
+func testCheckModifyTransactionCheck(server *fake.Server, resourceName string, update func(check *api_types.TransactionCheck)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testCheckID(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if !server.UpdateTransactionCheck(id, update) {
+			return fmt.Errorf("transaction check %d not found", id)
+		}
+		return nil
+	}
+}
+
+// testCheckDeleteTransactionCheck removes the transaction check from the fake
+// server, as if it was deleted in the Pingdom UI.
+func testCheckDeleteTransactionCheck(server *fake.Server, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testCheckID(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		server.DeleteTransactionCheck(id)
+		return nil
+	}
+}
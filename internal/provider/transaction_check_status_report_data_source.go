@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TransactionCheckStatusReportDataSource{}
+
+func NewTransactionCheckStatusReportDataSource() datasource.DataSource {
+	return &TransactionCheckStatusReportDataSource{}
+}
+
+type TransactionCheckStatusReportDataSource struct {
+	client api.Client
+}
+
+type TransactionCheckStatusReportDataSourceModel struct {
+	From     types.String `tfsdk:"from"`
+	To       types.String `tfsdk:"to"`
+	CheckIds types.Set    `tfsdk:"check_ids"`
+	Tags     types.Set    `tfsdk:"tags"`
+	Type     types.String `tfsdk:"type"`
+
+	Checks []TransactionCheckStatusReportModel `tfsdk:"checks"`
+}
+
+type TransactionCheckStatusReportModel struct {
+	Id     types.String                 `tfsdk:"id"`
+	Name   types.String                 `tfsdk:"name"`
+	States []TransactionCheckStateModel `tfsdk:"states"`
+}
+
+type TransactionCheckStateModel struct {
+	Status      types.String `tfsdk:"status"`
+	From        types.String `tfsdk:"from"`
+	To          types.String `tfsdk:"to"`
+	Message     types.String `tfsdk:"message"`
+	ErrorInStep types.Int64  `tfsdk:"error_in_step"`
+}
+
+func (d *TransactionCheckStatusReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transaction_check_status_report"
+}
+
+func (d *TransactionCheckStatusReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Periods in which transaction checks were successful, failing or unknown.",
+
+		Attributes: map[string]schema.Attribute{
+			"from": schema.StringAttribute{
+				MarkdownDescription: "The start of the report in RFC 3339 format, e.g. `2025-01-01T00:00:00Z`.",
+				Required:            true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "The end of the report in RFC 3339 format, e.g. `2025-02-01T00:00:00Z`.",
+				Required:            true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"check_ids": schema.SetAttribute{
+				MarkdownDescription: "Only report the checks with these IDs.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a numeric ID"),
+					),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Only report checks with at least one of these tags.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only report checks of this type. Allowed values are: script and recorded.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("script", "recorded"),
+				},
+			},
+			"checks": schema.ListNestedAttribute{
+				MarkdownDescription: "The reported checks, ordered by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the check.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the check.",
+							Computed:            true,
+						},
+						"states": schema.ListNestedAttribute{
+							MarkdownDescription: "The status periods within the range of the report, ordered by time.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"status": schema.StringAttribute{
+										MarkdownDescription: "The status of the check, e.g. successful, failing or unknown.",
+										Computed:            true,
+									},
+									"from": schema.StringAttribute{
+										MarkdownDescription: "The start of the period in RFC 3339 format.",
+										Computed:            true,
+									},
+									"to": schema.StringAttribute{
+										MarkdownDescription: "The end of the period in RFC 3339 format.",
+										Computed:            true,
+									},
+									"message": schema.StringAttribute{
+										MarkdownDescription: "The message describing why the check failed.",
+										Computed:            true,
+									},
+									"error_in_step": schema.Int64Attribute{
+										MarkdownDescription: "The index of the step which failed.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TransactionCheckStatusReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TransactionCheckStatusReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TransactionCheckStatusReportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from, to := parseReportRange(data.From, data.To, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := api.GetTransactionCheckStatusReportsRequest{
+		From: from,
+		To:   to,
+		Type: data.Type.ValueString(),
+	}
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &filter.Tags, false)...)
+
+	var checkIds []string
+	resp.Diagnostics.Append(data.CheckIds.ElementsAs(ctx, &checkIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reports, err := d.client.GetTransactionCheckStatusReports(ctx, filter)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read transaction check status report", err)
+		return
+	}
+
+	data.Checks = []TransactionCheckStatusReportModel{}
+	for _, report := range reports {
+		id := strconv.FormatInt(report.CheckId, 10)
+		// Pingdom only reports a single check or all of them.
+		if !data.CheckIds.IsNull() && !slices.Contains(checkIds, id) {
+			continue
+		}
+
+		states := []TransactionCheckStateModel{}
+		for _, state := range report.States {
+			states = append(states, TransactionCheckStateModel{
+				Status:      types.StringValue(state.Status),
+				From:        types.StringValue(state.From.UTC().Format(time.RFC3339)),
+				To:          types.StringValue(state.To.UTC().Format(time.RFC3339)),
+				Message:     types.StringValue(state.Message),
+				ErrorInStep: types.Int64Value(state.ErrorInStep),
+			})
+		}
+
+		data.Checks = append(data.Checks, TransactionCheckStatusReportModel{
+			Id:     types.StringValue(id),
+			Name:   types.StringValue(report.Name),
+			States: states,
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
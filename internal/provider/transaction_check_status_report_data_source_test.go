@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+func TestAccTransactionCheckStatusReportDataSource(t *testing.T) {
+	server := newTestServer(t)
+	login := addTestTransactionCheck(server, "Login", "script", "successful", "shop")
+	checkout := addTestTransactionCheck(server, "Checkout", "script", "failing", "shop")
+	addTestTransactionCheck(server, "Blog", "script", "successful", "blog")
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	server.AddTransactionCheckStates(login,
+		api_types.TransactionCheckState{Status: "successful", From: start, To: start.Add(10 * time.Hour)},
+		api_types.TransactionCheckState{Status: "failing", From: start.Add(10 * time.Hour), To: start.Add(11 * time.Hour), Message: "Element #Login not found", ErrorInStep: 1},
+		api_types.TransactionCheckState{Status: "successful", From: start.Add(11 * time.Hour), To: start.Add(48 * time.Hour)},
+	)
+	server.AddTransactionCheckStates(checkout,
+		api_types.TransactionCheckState{Status: "failing", From: start, To: start.Add(48 * time.Hour)},
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+data "pingdom_transaction_check_status_report" "shop" {
+  from = "2025-01-01T09:00:00Z"
+  to   = "2025-01-01T12:00:00Z"
+  tags = ["shop"]
+}
+
+data "pingdom_transaction_check_status_report" "checkout" {
+  from      = "2025-01-01T00:00:00Z"
+  to        = "2025-01-02T00:00:00.5Z"
+  check_ids = ["` + strconv.FormatInt(checkout, 10) + `"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_status_report.shop", "checks.#", "2"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_status_report.shop", "checks.0.id", strconv.FormatInt(login, 10)),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_status_report.shop", "checks.0.name", "Login"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_status_report.shop", "checks.0.states.#", "3"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_status_report.shop", "checks.0.states.1.status", "failing"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_status_report.shop", "checks.0.states.1.from", "2025-01-01T10:00:00Z"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_status_report.shop", "checks.0.states.1.to", "2025-01-01T11:00:00Z"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_status_report.shop", "checks.0.states.1.message", "Element #Login not found"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_status_report.shop", "checks.0.states.1.error_in_step", "1"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_status_report.checkout", "checks.#", "1"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_status_report.checkout", "checks.0.name", "Checkout"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_check_status_report.checkout", "checks.0.states.0.status", "failing"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+	"slices"
+	"strconv"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TransactionChecksDataSource{}
+
+func NewTransactionChecksDataSource() datasource.DataSource {
+	return &TransactionChecksDataSource{}
+}
+
+type TransactionChecksDataSource struct {
+	client api.Client
+}
+
+type TransactionChecksDataSourceModel struct {
+	Tags   types.Set    `tfsdk:"tags"`
+	Type   types.String `tfsdk:"type"`
+	Status types.String `tfsdk:"status"`
+
+	Ids    []types.String               `tfsdk:"ids"`
+	Checks []TransactionChecksItemModel `tfsdk:"checks"`
+}
+
+type TransactionChecksItemModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Paused        types.Bool   `tfsdk:"paused"`
+	Type          types.String `tfsdk:"type"`
+	Status        types.String `tfsdk:"status"`
+	Interval      types.Int64  `tfsdk:"interval"`
+	Region        types.String `tfsdk:"region"`
+	SeverityLevel types.String `tfsdk:"severity_level"`
+	Tags          types.Set    `tfsdk:"tags"`
+}
+
+func (d *TransactionChecksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transaction_checks"
+}
+
+func (d *TransactionChecksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the transaction checks, optionally filtered by tags, type and status. " +
+			"Use the `pingdom_transaction_check` data source to read the steps of a check.",
+
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Only return checks with at least one of these tags.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return checks of this type. Allowed values are: script and recorded.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("script", "recorded"),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return checks with this status, e.g. successful, failing or unknown.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching checks, ordered by ID.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"checks": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching checks, ordered by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the check.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the check.",
+							Computed:            true,
+						},
+						"paused": schema.BoolAttribute{
+							MarkdownDescription: "Whether the check is paused.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the check, either script or recorded.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The current status of the check, e.g. successful, failing or unknown.",
+							Computed:            true,
+						},
+						"interval": schema.Int64Attribute{
+							MarkdownDescription: "How frequent the check runs in minutes.",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "The region from which the check is performed.",
+							Computed:            true,
+						},
+						"severity_level": schema.StringAttribute{
+							MarkdownDescription: "The severity of the alerts.",
+							Computed:            true,
+						},
+						"tags": schema.SetAttribute{
+							MarkdownDescription: "The tags of the check.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TransactionChecksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TransactionChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TransactionChecksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := api.GetTransactionChecksRequest{
+		Type: data.Type.ValueString(),
+	}
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &filter.Tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checks, err := d.client.GetTransactionChecks(ctx, filter)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read transaction checks", err)
+		return
+	}
+
+	// Pingdom doesn't guarantee the order of the results.
+	slices.SortFunc(checks, func(a, b api_types.TransactionCheck) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	data.Ids = []types.String{}
+	data.Checks = []TransactionChecksItemModel{}
+	for _, check := range checks {
+		// Pingdom does not support filtering by status.
+		if !data.Status.IsNull() && check.Status != data.Status.ValueString() {
+			continue
+		}
+
+		tags := check.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		tfTags, diagnostics := types.SetValueFrom(ctx, types.StringType, tags)
+		resp.Diagnostics.Append(diagnostics...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id := types.StringValue(strconv.FormatInt(check.Id, 10))
+		data.Ids = append(data.Ids, id)
+		data.Checks = append(data.Checks, TransactionChecksItemModel{
+			Id:            id,
+			Name:          types.StringValue(check.Name),
+			Paused:        types.BoolValue(!check.Active),
+			Type:          types.StringValue(check.Type),
+			Status:        types.StringValue(check.Status),
+			Interval:      types.Int64Value(check.Interval),
+			Region:        types.StringValue(check.Region),
+			SeverityLevel: types.StringValue(check.SeverityLevel),
+			Tags:          tfTags,
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
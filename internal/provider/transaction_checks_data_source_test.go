@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTransactionChecksDataSource(t *testing.T) {
+	server := newTestServer(t)
+	checkout := addTestTransactionCheck(server, "Checkout", "script", "successful", "shop")
+	login := addTestTransactionCheck(server, "Login", "script", "failing", "shop", "auth")
+	addTestTransactionCheck(server, "Recorded", "recorded", "failing", "shop")
+	addTestTransactionCheck(server, "Blog", "script", "failing", "blog")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+data "pingdom_transaction_checks" "all" {}
+
+data "pingdom_transaction_checks" "shop" {
+  tags = ["shop"]
+  type = "script"
+}
+
+data "pingdom_transaction_checks" "failing_shop" {
+  tags   = ["shop"]
+  type   = "script"
+  status = "failing"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pingdom_transaction_checks.all", "ids.#", "4"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_checks.shop", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_checks.shop", "ids.0", strconv.FormatInt(checkout, 10)),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_checks.shop", "ids.1", strconv.FormatInt(login, 10)),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_checks.failing_shop", "checks.#", "1"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_checks.failing_shop", "checks.0.id", strconv.FormatInt(login, 10)),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_checks.failing_shop", "checks.0.name", "Login"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_checks.failing_shop", "checks.0.status", "failing"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_checks.failing_shop", "checks.0.region", "eu"),
+					resource.TestCheckResourceAttr("data.pingdom_transaction_checks.failing_shop", "checks.0.paused", "false"),
+					resource.TestCheckTypeSetElemAttr("data.pingdom_transaction_checks.failing_shop", "checks.0.tags.*", "auth"),
+				),
+			},
+		},
+	})
+}
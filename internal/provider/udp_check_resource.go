@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scayle/terraform-provider-pingdom/internal/api"
+	api_types "github.com/scayle/terraform-provider-pingdom/internal/api/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UDPCheckResource{}
+var _ resource.ResourceWithImportState = &UDPCheckResource{}
+var _ resource.ResourceWithValidateConfig = &UDPCheckResource{}
+
+func NewUDPCheckResource() resource.Resource {
+	return &UDPCheckResource{}
+}
+
+type UDPCheckResource struct {
+	checkResource
+}
+
+type UDPCheckResourceModel struct {
+	CheckModel
+
+	Port           types.Int64  `tfsdk:"port"`
+	StringToSend   types.String `tfsdk:"string_to_send"`
+	StringToExpect types.String `tfsdk:"string_to_expect"`
+}
+
+func (r *UDPCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_udp_check"
+}
+
+func (r *UDPCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sends a string to a UDP port of a host and checks whether the response contains the expected string.",
+
+		Attributes: checkSchemaAttributes(map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The UDP port to send the string to.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"string_to_send": schema.StringAttribute{
+				MarkdownDescription: "The string which is sent to the port.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"string_to_expect": schema.StringAttribute{
+				MarkdownDescription: "Trigger a downtime if the response does not contain this string.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		}),
+	}
+}
+
+func (r *UDPCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateCheckConfig(ctx, req.Config, &resp.Diagnostics)
+}
+
+func transformPingdomCheckToUDPModel(check api_types.Check) (UDPCheckResourceModel, diag.Diagnostics) {
+	checkModel, diagnostics := transformPingdomCheckToCheckModel(check)
+	if diagnostics.HasError() {
+		return UDPCheckResourceModel{}, diagnostics
+	}
+
+	return UDPCheckResourceModel{
+		CheckModel: checkModel,
+
+		Port:           types.Int64Value(check.Type.UDP.Port),
+		StringToSend:   types.StringValue(check.Type.UDP.StringToSend),
+		StringToExpect: types.StringValue(check.Type.UDP.StringToExpect),
+	}, nil
+}
+
+func createUDPCheckRequestModel(resourceModel UDPCheckResourceModel) api.CreateCheckRequest {
+	body := resourceModel.createCheckRequest("udp")
+	body.UDP = &api.UDPCheckRequest{
+		Port:           resourceModel.Port.ValueInt64(),
+		StringToSend:   resourceModel.StringToSend.ValueString(),
+		StringToExpect: resourceModel.StringToExpect.ValueString(),
+	}
+
+	return body
+}
+
+func (r *UDPCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model UDPCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.createCheck(ctx, createUDPCheckRequestModel(model), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToUDPModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *UDPCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model UDPCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.readCheck(ctx, model.Id.ValueString(), "udp", resp)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToUDPModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *UDPCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UDPCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check := r.updateCheck(ctx, data.Id.ValueString(), createUDPCheckRequestModel(data), &resp.Diagnostics)
+	if check == nil {
+		return
+	}
+
+	model, diagnostics := transformPingdomCheckToUDPModel(*check)
+	if diagnostics.HasError() {
+		resp.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
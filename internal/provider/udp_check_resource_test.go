@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUDPCheckResource(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_udp_check" "test" {
+  name             = "Example"
+  host             = "game.example.com"
+  port             = 27015
+  string_to_send   = "ping"
+  string_to_expect = "pong"
+  contact_ids      = []
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pingdom_udp_check.test", "id"),
+					resource.TestCheckResourceAttr("pingdom_udp_check.test", "port", "27015"),
+					resource.TestCheckResourceAttr("pingdom_udp_check.test", "string_to_send", "ping"),
+					resource.TestCheckResourceAttr("pingdom_udp_check.test", "string_to_expect", "pong"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pingdom_udp_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_udp_check" "test" {
+  name             = "Example"
+  host             = "game.example.com"
+  port             = 27016
+  string_to_send   = "status"
+  string_to_expect = "ok"
+  regions          = ["NA"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdom_udp_check.test", "port", "27016"),
+					resource.TestCheckResourceAttr("pingdom_udp_check.test", "string_to_send", "status"),
+					resource.TestCheckResourceAttr("pingdom_udp_check.test", "string_to_expect", "ok"),
+					resource.TestCheckResourceAttr("pingdom_udp_check.test", "regions.0", "NA"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUDPCheckResource_missingStringToExpect(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "pingdom_udp_check" "test" {
+  name           = "Example"
+  host           = "game.example.com"
+  port           = 27015
+  string_to_send = "ping"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"string_to_expect" is required`),
+			},
+		},
+	})
+}
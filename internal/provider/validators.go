@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ipAddress validates that the string is an IPv4 or IPv6 address.
+func ipAddress() validator.String {
+	return ipAddressValidator{}
+}
+
+type ipAddressValidator struct{}
+
+func (v ipAddressValidator) Description(_ context.Context) string {
+	return "value must be a valid IPv4 or IPv6 address"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := netip.ParseAddr(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("The value %q is not a valid IPv4 or IPv6 address.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// rfc3339 validates that the string is a timestamp in RFC 3339 format, e.g.
+// 2025-01-31T22:00:00Z.
+func rfc3339() validator.String {
+	return rfc3339Validator{}
+}
+
+// maintenanceTimestamp validates that the string is a timestamp in RFC 3339
+// format without fractional seconds, as Pingdom stores the times of
+// maintenance windows in whole seconds.
+func maintenanceTimestamp() validator.String {
+	return rfc3339Validator{wholeSeconds: true}
+}
+
+type rfc3339Validator struct {
+	wholeSeconds bool
+}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	if v.wholeSeconds {
+		return "value must be a timestamp in RFC 3339 format without fractional seconds"
+	}
+
+	return "value must be a timestamp in RFC 3339 format"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("The value %q is not a timestamp in RFC 3339 format, e.g. 2025-01-31T22:00:00Z.", req.ConfigValue.ValueString()),
+		)
+		return
+	}
+
+	if v.wholeSeconds && timestamp.Nanosecond() != 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("The value %q has fractional seconds, which are not supported by Pingdom.", req.ConfigValue.ValueString()),
+		)
+	}
+}